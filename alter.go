@@ -0,0 +1,286 @@
+package parser
+
+// AlterTable
+// Query: ALTER TABLE users ADD COLUMN age int NOT NULL DEFAULT 0
+//		  ALTER TABLE users DROP COLUMN age, RENAME COLUMN name TO full_name
+//		  ALTER TABLE users ALTER COLUMN name SET DEFAULT '', ALTER COLUMN name DROP NOT NULL
+//		  ALTER TABLE users ADD CONSTRAINT uk_name UNIQUE (name), DROP INDEX idx_age
+// <alter table statement> ::= ALTER TABLE <table name> <alter table action> [ { <comma> <alter table action> }... ]
+// <alter table action> ::=
+//		ADD [ COLUMN ] <column definition>
+//	|	ADD <table constraint definition>
+//	|	DROP [ COLUMN ] [ IF EXISTS ] <column name>
+//	|	DROP CONSTRAINT <constraint name>
+//	|	DROP { INDEX | KEY } <index name>
+//	|	MODIFY [ COLUMN ] <column definition>
+//	|	CHANGE [ COLUMN ] <column name> <column definition>
+//	|	RENAME COLUMN <column name> TO <column name>
+//	|	RENAME [ TO | AS ] <table name>
+//	|	ALTER [ COLUMN ] <column name> { SET DEFAULT <default option> | DROP DEFAULT | SET NOT NULL | DROP NOT NULL }
+
+type AlterTable struct {
+	Span
+	Table   TableReference
+	Actions []*AlterTableAction
+}
+
+const (
+	AlterTableActionAddColumn = iota
+	AlterTableActionDropColumn
+	AlterTableActionModifyColumn
+	AlterTableActionChangeColumn
+	AlterTableActionRenameColumn
+	AlterTableActionAddConstraint
+	AlterTableActionDropConstraint
+	AlterTableActionDropIndex
+	AlterTableActionSetDefault
+	AlterTableActionDropDefault
+	AlterTableActionSetNotNull
+	AlterTableActionDropNotNull
+	AlterTableActionRenameTable
+)
+
+type AlterTableActionType int
+
+type AlterTableAction struct {
+	Span
+	Type AlterTableActionType
+	// Column is the name of the column which the action changes. It is the old name for CHANGE and RENAME COLUMN.
+	Column string
+	// Name is the name of the constraint or the index for DROP CONSTRAINT and DROP INDEX.
+	Name string
+	// NewName is the new name of RENAME COLUMN and RENAME TO.
+	NewName string
+	// IfExists is true for DROP COLUMN IF EXISTS.
+	IfExists bool
+	// Definition is the column of ADD, MODIFY and CHANGE COLUMN. The name of Definition is the new name for CHANGE.
+	Definition *ColumnDefinition
+	Constraint *TableConstraint
+	Default    Expr
+}
+
+func (*AlterTable) statementNode() {}
+
+func (p *Parser) parseAlterTable(tokens TokenReader) (Statement, error) {
+	start, err := p.expect(tokens, ALTER)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens, TABLE); err != nil {
+		return nil, err
+	}
+	query := &AlterTable{Span: Span{StartPos: start.Position}}
+
+	table, err := p.parseTableName(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.Table = table
+
+	list := p.collect(tokens, func(Token) bool { return false })
+	if len(list) == 0 {
+		return nil, p.unexpected(peekToken(tokens), ADD, ALTER, IDENT)
+	}
+	elements, err := p.splitElements(list[0], list)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range elementReaders(list, elements, peekToken(tokens)) {
+		action, err := p.parseAlterTableAction(e)
+		if err != nil {
+			return nil, err
+		}
+		query.Actions = append(query.Actions, action)
+	}
+
+	if err := p.expectEnd(tokens); err != nil {
+		return nil, err
+	}
+	query.EndPos = p.reader.last.End
+
+	return query, nil
+}
+
+func (p *Parser) parseAlterTableAction(tokens TokenReader) (*AlterTableAction, error) {
+	t, _ := tokens.Scan()
+	res := &AlterTableAction{Span: Span{StartPos: t.Position}}
+
+	var err error
+	switch {
+	case t.Type == ADD:
+		err = p.parseAddAction(tokens, res)
+	case t.Type == DROP:
+		err = p.parseDropAction(tokens, res)
+	case isKeyword(t, "modify"):
+		res.Type = AlterTableActionModifyColumn
+		skipColumn(tokens)
+		res.Definition, err = p.parseColumnDefinition(tokens)
+	case isKeyword(t, "change"):
+		res.Type = AlterTableActionChangeColumn
+		skipColumn(tokens)
+		var column Token
+		if column, err = p.expect(tokens, IDENT, QUOTED_IDENT); err == nil {
+			res.Column = column.Value
+			res.Definition, err = p.parseColumnDefinition(tokens)
+		}
+	case isKeyword(t, "rename"):
+		err = p.parseRenameAction(tokens, res)
+	case t.Type == ALTER:
+		err = p.parseAlterColumnAction(tokens, res)
+	default:
+		return nil, p.newError(t, "unexpected "+describeToken(t)+", expected ADD, DROP, MODIFY, CHANGE, RENAME or ALTER", []TokenType{ADD, ALTER, IDENT})
+	}
+	if err != nil {
+		return nil, err
+	}
+	res.EndPos = lastEnd(tokens)
+
+	return res, p.expectEnd(tokens)
+}
+
+func (p *Parser) parseAddAction(tokens TokenReader, res *AlterTableAction) error {
+	if t := peekTokens(tokens, 4); t[0].Type == UNIQUE || isTableConstraint(t) {
+		res.Type = AlterTableActionAddConstraint
+		constraint, err := p.parseTableConstraint(tokens)
+		if err != nil {
+			return err
+		}
+		res.Constraint = constraint
+		return nil
+	}
+
+	res.Type = AlterTableActionAddColumn
+	skipColumn(tokens)
+	definition, err := p.parseColumnDefinition(tokens)
+	if err != nil {
+		return err
+	}
+	res.Definition = definition
+
+	return nil
+}
+
+func (p *Parser) parseDropAction(tokens TokenReader, res *AlterTableAction) error {
+	t := peekToken(tokens)
+	switch {
+	case isKeyword(t, "constraint"):
+		res.Type = AlterTableActionDropConstraint
+	case t.Type == INDEX, isKeyword(t, "key"):
+		res.Type = AlterTableActionDropIndex
+	default:
+		res.Type = AlterTableActionDropColumn
+		skipColumn(tokens)
+		ifExists, err := p.parseIfExists(tokens)
+		if err != nil {
+			return err
+		}
+		res.IfExists = ifExists
+		column, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+		if err != nil {
+			return err
+		}
+		res.Column = column.Value
+		return nil
+	}
+
+	tokens.Discard(1)
+	name, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+	if err != nil {
+		return err
+	}
+	res.Name = name.Value
+
+	return nil
+}
+
+func (p *Parser) parseRenameAction(tokens TokenReader, res *AlterTableAction) error {
+	t := peekToken(tokens)
+	if t.Type != COLUMN {
+		res.Type = AlterTableActionRenameTable
+		if t.Type == AS || isKeyword(t, "to") {
+			tokens.Discard(1)
+		}
+		table, err := p.parseTableName(tokens)
+		if err != nil {
+			return err
+		}
+		res.NewName = table.Name
+		return nil
+	}
+
+	tokens.Discard(1)
+	res.Type = AlterTableActionRenameColumn
+	column, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+	if err != nil {
+		return err
+	}
+	res.Column = column.Value
+	if _, err := p.expectKeyword(tokens, "to"); err != nil {
+		return err
+	}
+	name, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+	if err != nil {
+		return err
+	}
+	res.NewName = name.Value
+
+	return nil
+}
+
+func (p *Parser) parseAlterColumnAction(tokens TokenReader, res *AlterTableAction) error {
+	skipColumn(tokens)
+	column, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+	if err != nil {
+		return err
+	}
+	res.Column = column.Value
+
+	t := peekToken(tokens)
+	tokens.Discard(1)
+	switch {
+	case t.Type == SET:
+		if peekToken(tokens).Type == DEFAULT {
+			tokens.Discard(1)
+			res.Type = AlterTableActionSetDefault
+			res.Default, err = p.parseDefaultValue(tokens)
+			return err
+		}
+		res.Type = AlterTableActionSetNotNull
+	case t.Type == DROP:
+		if peekToken(tokens).Type == DEFAULT {
+			tokens.Discard(1)
+			res.Type = AlterTableActionDropDefault
+			return nil
+		}
+		res.Type = AlterTableActionDropNotNull
+	default:
+		return p.newError(t, "unexpected "+describeToken(t)+", expected SET or DROP", []TokenType{SET, IDENT})
+	}
+
+	if _, err := p.expect(tokens, NOT); err != nil {
+		return err
+	}
+	_, err = p.expect(tokens, NULL)
+
+	return err
+}
+
+// parseIfExists parses an optional IF EXISTS.
+func (p *Parser) parseIfExists(tokens TokenReader) (bool, error) {
+	if t := peekToken(tokens); t.Type != IF {
+		return false, nil
+	}
+	tokens.Discard(1)
+	if _, err := p.expect(tokens, EXIST); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// skipColumn skips the optional COLUMN keyword.
+func skipColumn(tokens TokenReader) {
+	if peekToken(tokens).Type == COLUMN {
+		tokens.Discard(1)
+	}
+}
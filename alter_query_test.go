@@ -0,0 +1,95 @@
+package parser
+
+var TestAlterTableQuery = []TestQuery{
+	{ // # 0
+		Query: "alter table users add column age int not null default 0",
+		Ast: &AlterTable{
+			Table: TableReference{Name: "users"},
+			Actions: []*AlterTableAction{
+				{Type: AlterTableActionAddColumn, Definition: &ColumnDefinition{Name: "age", Type: DataType{Name: "int"}, NotNull: true, Default: ValueExpr{Type: ValueTypeInt}}},
+			},
+		},
+	},
+	{ // # 1
+		Query: "ALTER TABLE users DROP COLUMN IF EXISTS age, DROP name, MODIFY COLUMN email varchar(512), CHANGE title subject varchar(64) NOT NULL, RENAME COLUMN login TO username",
+		Ast: &AlterTable{
+			Table: TableReference{Name: "users"},
+			Actions: []*AlterTableAction{
+				{Type: AlterTableActionDropColumn, Column: "age", IfExists: true},
+				{Type: AlterTableActionDropColumn, Column: "name"},
+				{Type: AlterTableActionModifyColumn, Definition: &ColumnDefinition{Name: "email", Type: DataType{Name: "varchar", Args: []int{512}}}},
+				{Type: AlterTableActionChangeColumn, Column: "title", Definition: &ColumnDefinition{Name: "subject", Type: DataType{Name: "varchar", Args: []int{64}}, NotNull: true}},
+				{Type: AlterTableActionRenameColumn, Column: "login", NewName: "username"},
+			},
+		},
+	},
+	{ // # 2
+		Query: "alter table users add constraint uk_name unique (name), add index idx_age (age), add primary key (id), drop constraint fk_user, drop index idx_email",
+		Ast: &AlterTable{
+			Table: TableReference{Name: "users"},
+			Actions: []*AlterTableAction{
+				{Type: AlterTableActionAddConstraint, Constraint: &TableConstraint{Name: "uk_name", Type: ConstraintTypeUnique, Columns: []string{"name"}}},
+				{Type: AlterTableActionAddConstraint, Constraint: &TableConstraint{Name: "idx_age", Type: ConstraintTypeIndex, Columns: []string{"age"}}},
+				{Type: AlterTableActionAddConstraint, Constraint: &TableConstraint{Type: ConstraintTypePrimaryKey, Columns: []string{"id"}}},
+				{Type: AlterTableActionDropConstraint, Name: "fk_user"},
+				{Type: AlterTableActionDropIndex, Name: "idx_email"},
+			},
+		},
+	},
+	{ // # 3
+		Query: "alter table users alter column name set default 'none', alter name drop default, alter column age set not null, alter column age drop not null",
+		Ast: &AlterTable{
+			Table: TableReference{Name: "users"},
+			Actions: []*AlterTableAction{
+				{Type: AlterTableActionSetDefault, Column: "name", Default: ValueExpr{Type: ValueTypeString, StringValue: "none"}},
+				{Type: AlterTableActionDropDefault, Column: "name"},
+				{Type: AlterTableActionSetNotNull, Column: "age"},
+				{Type: AlterTableActionDropNotNull, Column: "age"},
+			},
+		},
+	},
+	{ // # 4
+		Query: "alter table users rename to members",
+		Ast: &AlterTable{
+			Table:   TableReference{Name: "users"},
+			Actions: []*AlterTableAction{{Type: AlterTableActionRenameTable, NewName: "members"}},
+		},
+	},
+	{ // # 5
+		Query: "alter table kv add key int, add key idx_key (key)",
+		Ast: &AlterTable{
+			Table: TableReference{Name: "kv"},
+			Actions: []*AlterTableAction{
+				{Type: AlterTableActionAddColumn, Definition: &ColumnDefinition{Name: "key", Type: DataType{Name: "int"}}},
+				{Type: AlterTableActionAddConstraint, Constraint: &TableConstraint{Name: "idx_key", Type: ConstraintTypeIndex, Columns: []string{"key"}}},
+			},
+		},
+	},
+}
+
+var TestAlterTableErrorQuery = []TestErrorQuery{
+	{ // # 0
+		Query:    "ALTER TABLE t ADD, DROP a",
+		Position: Position{Line: 1, Offset: 17, Column: 18},
+	},
+	{ // # 1
+		Query:    "ALTER TABLE t ALTER COLUMN a SET",
+		Position: Position{Line: 1, Offset: 32, Column: 33},
+	},
+	{ // # 2
+		Query:    "ALTER TABLE t ALTER COLUMN a",
+		Position: Position{Line: 1, Offset: 28, Column: 29},
+	},
+	{ // # 3
+		Query:    "ALTER TABLE t RENAME COLUMN a",
+		Position: Position{Line: 1, Offset: 29, Column: 30},
+	},
+	{ // # 4
+		Query:    "ALTER TABLE t CHANGE",
+		Position: Position{Line: 1, Offset: 20, Column: 21},
+	},
+	{ // # 5
+		Query:    "ALTER TABLE t MODIFY",
+		Position: Position{Line: 1, Offset: 20, Column: 21},
+	},
+}
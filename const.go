@@ -1,5 +1,7 @@
 package parser
 
+import "math/big"
+
 //go:generate stringer -type=TokenType
 
 type TokenType int
@@ -9,20 +11,44 @@ const (
 	EOF
 	WS
 
-	INT
+	INT     // 1, 0x1F
+	DECIMAL // 3.14
+	FLOAT   // 1e10, 3.14e-2
 	IDENT
+	QUOTED_IDENT // `name`, "name" or [name]
+	STRING
+	COMMENT // -- comment, # comment or /* comment */
 
-	ASTERISK // *
-	COMMA    // ,
-	PERIOD   // .
-	LPAREN   // (
-	RPAREN   // )
-	ADD      // +
-	SUB      // -
-	EQUAL    // =
-	LSS      // <
-	GTR      // >
-	QUESTION // ?
+	ASTERISK      // *
+	COMMA         // ,
+	PERIOD        // .
+	LPAREN        // (
+	RPAREN        // )
+	PLUS          // +
+	MINUS         // -
+	SLASH         // /
+	PERCENT       // %
+	EQUAL         // =
+	LSS           // <
+	GTR           // >
+	LEQ           // <=
+	GEQ           // >=
+	NEQ           // <> or !=
+	NULLSAFEEQUAL // <=>
+	CONCAT        // ||
+	DOUBLECOLON   // ::
+	ARROW         // ->
+	LONGARROW     // ->>
+	AMPERSAND     // &
+	PIPE          // |
+	CARET         // ^
+	TILDE         // ~
+	SHL           // <<
+	SHR           // >>
+	QUESTION      // ?
+	PARAM         // $1, :name or @name
+	SEMICOLON     // ; or the delimiter set by DELIMITER
+	DELIMITER     // DELIMITER $$
 
 	SELECT
 	INSERT
@@ -30,6 +56,7 @@ const (
 	DELETE
 	CREATE
 	ALTER
+	ADD
 	DROP
 	FROM
 	AS
@@ -46,6 +73,7 @@ const (
 	GROUPBY
 	ORDERBY
 	HAVING
+	LIMIT
 	ONDUPLICATEKEYUPDATE
 	DESC
 	ASC
@@ -72,15 +100,50 @@ const (
 	VARCHAR
 )
 
+// Dialect selects the SQL flavour whose quoting and escaping rules the Lexer follows.
+type Dialect int
+
+const (
+	DialectMySQL Dialect = iota
+	DialectPostgreSQL
+	DialectSQLServer
+)
+
+// IdentifierFolding is the case conversion applied to unquoted identifiers.
+type IdentifierFolding int
+
+const (
+	FoldLower IdentifierFolding = iota // like PostgreSQL
+	FoldNone
+	FoldUpper // like Oracle
+)
+
 type Token struct {
-	Type     TokenType
+	Type TokenType
+	// Position is the position of the first rune of the token and End is the position just after the last rune.
 	Position Position
-	Value    string
-	IntValue int
+	End      Position
+	// Text is the source text of the token as it is written.
+	Text string
+	// Value is the source text for numeric literals, the folded name for unquoted identifiers
+	// and the reason of the error for ILLEGAL.
+	Value      string
+	IntValue   int
+	FloatValue float64
+	// BigIntValue is set only when an INT literal overflows int.
+	BigIntValue *big.Int
+	// BigFloatValue holds a DECIMAL or FLOAT literal with enough precision for its source text.
+	BigFloatValue *big.Float
+
+	// LeadingTrivia and TrailingTrivia hold COMMENT tokens around the token.
+	// These are populated only when the Lexer is created with WithTrivia.
+	LeadingTrivia  []Token
+	TrailingTrivia []Token
 }
 
+// Position is a location in the input. Line and Column start at 1.
 type Position struct {
 	Line   int
-	Offset int
-	Column int
+	Offset int // in bytes from the beginning of the input
+	Column int // in runes from the beginning of the line
 }
@@ -0,0 +1,556 @@
+package parser
+
+import (
+	"strings"
+)
+
+// CreateTable
+// Query: CREATE TABLE users (id int)
+//		  CREATE TABLE IF NOT EXISTS users (id serial PRIMARY KEY, name varchar(255) NOT NULL DEFAULT '')
+//		  CREATE TABLE blog (id int, user_id int, CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id))
+// <table definition> ::= CREATE TABLE [ IF NOT EXISTS ] <table name> <left paren> <table element list> <right paren>
+// <table element> ::= <column definition> | <table constraint definition>
+// <column definition> ::= <column name> <data type> [ <column constraint definition>... ]
+// <data type> ::= <type name> [ <left paren> <precision> [ <comma> <scale> ] <right paren> ] [ UNSIGNED ]
+//	|	<type name> <left paren> <character string literal> [ { <comma> <character string literal> }... ] <right paren>
+//	|	{ TIME | TIMESTAMP } [ <left paren> <precision> <right paren> ] [ { WITH | WITHOUT } TIME ZONE ]
+// <column constraint definition> ::=
+//		NOT NULL | NULL | DEFAULT <default option> | PRIMARY KEY | UNIQUE [ KEY ] | AUTO_INCREMENT
+//	|	<references specification> | CHECK <left paren> <search condition> <right paren>
+//	|	CONSTRAINT <constraint name>
+// <table constraint definition> ::= [ CONSTRAINT <constraint name> ] <table constraint>
+// <table constraint> ::=
+//		PRIMARY KEY <left paren> <column name list> <right paren>
+//	|	UNIQUE [ KEY | INDEX ] [ <index name> ] <left paren> <column name list> <right paren>
+//	|	FOREIGN KEY <left paren> <column name list> <right paren> <references specification>
+//	|	CHECK <left paren> <search condition> <right paren>
+//	|	{ KEY | INDEX } [ <index name> ] <left paren> <column name list> <right paren>
+// <references specification> ::= REFERENCES <table name> [ <left paren> <column name list> <right paren> ]
+//		[ ON DELETE <referential action> ] [ ON UPDATE <referential action> ]
+
+type CreateTable struct {
+	Span
+	Table       TableReference
+	IfNotExists bool
+	Columns     []*ColumnDefinition
+	Constraints []*TableConstraint
+}
+
+type ColumnDefinition struct {
+	Span
+	Name          string
+	Type          DataType
+	NotNull       bool
+	PrimaryKey    bool
+	Unique        bool
+	AutoIncrement bool
+	// Default is nil when the column has no DEFAULT.
+	Default    Expr
+	References *References
+	Check      Expr
+}
+
+// DataType is the type of a column. Name is lower case and the words of a multi-word type such as "double precision"
+// are joined by a space. Args is the length or the precision and the scale, and Values is the values of enum and set.
+type DataType struct {
+	Span
+	Name     string
+	Args     []int
+	Values   []string
+	Unsigned bool
+}
+
+// dataTypeWords is the second words of the multi-word type names keyed by their first words.
+var dataTypeWords = map[string]string{
+	"double":    "precision",
+	"character": "varying",
+	"char":      "varying",
+	"bit":       "varying",
+}
+
+const (
+	ConstraintTypePrimaryKey = iota
+	ConstraintTypeUnique
+	ConstraintTypeForeignKey
+	ConstraintTypeCheck
+	ConstraintTypeIndex // KEY and INDEX of MySQL
+)
+
+type ConstraintType int
+
+type TableConstraint struct {
+	Span
+	// Name is the name of CONSTRAINT or the index name of MySQL.
+	Name       string
+	Type       ConstraintType
+	Columns    []string
+	References *References
+	Check      Expr
+}
+
+// References is the REFERENCES clause of a foreign key. OnDelete and OnUpdate are the referential actions in lower case
+// such as "cascade" and "set null".
+type References struct {
+	Span
+	Table    TableReference
+	Columns  []string
+	OnDelete string
+	OnUpdate string
+}
+
+func (*CreateTable) statementNode() {}
+
+func (p *Parser) parseCreate(tokens TokenReader) (Statement, error) {
+	t, err := tokens.Peek(2)
+	if err != nil {
+		return nil, p.unexpected(Token{Type: EOF}, TABLE, INDEX, UNIQUE, DATABASE, IDENT)
+	}
+
+	switch {
+	case t[1].Type == TABLE:
+		return p.parseCreateTable(tokens)
+	case t[1].Type == INDEX, t[1].Type == UNIQUE:
+		return p.parseCreateIndex(tokens)
+	case t[1].Type == DATABASE:
+		return p.parseCreateDatabase(tokens)
+	case isKeyword(t[1], "schema"):
+		return p.parseCreateSchema(tokens)
+	case t[1].Type == OR, isKeyword(t[1], "view"):
+		return p.parseCreateView(tokens)
+	case isKeyword(t[1], "materialized"):
+		return p.parseCreateMaterializedView(tokens)
+	}
+
+	return nil, p.unexpected(t[1], TABLE, INDEX, UNIQUE, DATABASE, IDENT)
+}
+
+func (p *Parser) parseCreateTable(tokens TokenReader) (Statement, error) {
+	start, err := p.expect(tokens, CREATE)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens, TABLE); err != nil {
+		return nil, err
+	}
+	query := &CreateTable{Span: Span{StartPos: start.Position}}
+
+	ifNotExists, err := p.parseIfNotExists(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.IfNotExists = ifNotExists
+
+	table, err := p.parseTableName(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.Table = table
+
+	elements, err := p.parseParenElements(tokens)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range elements {
+		if isTableConstraint(peekTokens(e, 4)) {
+			c, err := p.parseTableConstraint(e)
+			if err != nil {
+				return nil, err
+			}
+			query.Constraints = append(query.Constraints, c)
+			continue
+		}
+
+		c, err := p.parseColumnDefinition(e)
+		if err != nil {
+			return nil, err
+		}
+		query.Columns = append(query.Columns, c)
+	}
+
+	if err := p.expectEnd(tokens); err != nil {
+		return nil, err
+	}
+	query.EndPos = p.reader.last.End
+
+	return query, nil
+}
+
+// parseIfNotExists parses an optional IF NOT EXISTS.
+func (p *Parser) parseIfNotExists(tokens TokenReader) (bool, error) {
+	if t := peekToken(tokens); t.Type != IF {
+		return false, nil
+	}
+	tokens.Discard(1)
+	if _, err := p.expect(tokens, NOT); err != nil {
+		return false, err
+	}
+	if _, err := p.expect(tokens, EXIST); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func isTableConstraint(tokens Tokens) bool {
+	switch tokens[0].Type {
+	case PRIMARYKEY, CHECK, INDEX:
+		return true
+	case UNIQUE:
+		// UNIQUE (a, b), UNIQUE KEY name (a) and UNIQUE INDEX name (a)
+		return len(tokens) > 1 && (tokens[1].Type == LPAREN || tokens[1].Type == INDEX || isKeyword(tokens[1], "key"))
+	}
+
+	return isKeyword(tokens[0], "constraint") || isKeyword(tokens[0], "foreign") || (isKeyword(tokens[0], "key") && isIndexColumns(tokens[1:]))
+}
+
+// isIndexColumns reports whether tokens are the index name and the column list which follow KEY of MySQL.
+// KEY is a column name when the tokens are a data type such as varchar(10) and decimal(10, 2).
+func isIndexColumns(tokens Tokens) bool {
+	if len(tokens) > 0 && (tokens[0].Type == IDENT || tokens[0].Type == QUOTED_IDENT) {
+		tokens = tokens[1:]
+	}
+
+	return len(tokens) > 1 && tokens[0].Type == LPAREN && (tokens[1].Type == IDENT || tokens[1].Type == QUOTED_IDENT)
+}
+
+func (p *Parser) parseColumnDefinition(tokens TokenReader) (*ColumnDefinition, error) {
+	name, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+	if err != nil {
+		return nil, err
+	}
+	res := &ColumnDefinition{Span: Span{StartPos: name.Position}, Name: name.Value}
+
+	dataType, err := p.parseDataType(tokens)
+	if err != nil {
+		return nil, err
+	}
+	res.Type = dataType
+	res.EndPos = dataType.EndPos
+
+	for {
+		t, err := tokens.Scan()
+		if err != nil || t.Type == EOF {
+			return res, nil
+		}
+
+		switch {
+		case t.Type == NOT:
+			if _, err := p.expect(tokens, NULL); err != nil {
+				return nil, err
+			}
+			res.NotNull = true
+		case t.Type == NULL:
+			res.NotNull = false
+		case t.Type == DEFAULT:
+			v, err := p.parseDefaultValue(tokens)
+			if err != nil {
+				return nil, err
+			}
+			res.Default = v
+		case t.Type == PRIMARYKEY:
+			res.PrimaryKey = true
+		case t.Type == UNIQUE:
+			if isKeyword(peekToken(tokens), "key") {
+				tokens.Discard(1)
+			}
+			res.Unique = true
+		case t.Type == REFERENCE:
+			references, err := p.parseReferences(t, tokens)
+			if err != nil {
+				return nil, err
+			}
+			res.References = references
+		case t.Type == CHECK:
+			check, err := p.parseCheck(tokens)
+			if err != nil {
+				return nil, err
+			}
+			res.Check = check
+		case isKeyword(t, "auto_increment"):
+			res.AutoIncrement = true
+		case isKeyword(t, "constraint"):
+			if _, err := p.expect(tokens, IDENT, QUOTED_IDENT); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, p.unexpected(t, NOT, NULL, DEFAULT, PRIMARYKEY, UNIQUE, REFERENCE, CHECK)
+		}
+		res.EndPos = lastEnd(tokens)
+	}
+}
+
+func (p *Parser) parseDataType(tokens TokenReader) (DataType, error) {
+	t, err := p.expect(tokens, IDENT, INTEGER, SERIAL, VARCHAR)
+	if err != nil {
+		return DataType{}, err
+	}
+	res := DataType{Span: tokensSpan(Tokens{t}), Name: keywordText(t)}
+	if word, ok := dataTypeWords[res.Name]; ok {
+		if next := peekToken(tokens); isKeyword(next, word) {
+			tokens.Discard(1)
+			res.Name += " " + word
+			res.EndPos = next.End
+		}
+	}
+
+	if next := peekToken(tokens); next.Type == LPAREN {
+		args, err := p.parseParenList(tokens)
+		if err != nil {
+			return DataType{}, err
+		}
+		for _, arg := range args {
+			switch {
+			case len(arg) == 1 && arg[0].Type == INT:
+				res.Args = append(res.Args, arg[0].IntValue)
+			case len(arg) == 1 && arg[0].Type == STRING:
+				res.Values = append(res.Values, arg[0].Value)
+			default:
+				return DataType{}, p.unexpected(arg[0], INT, STRING)
+			}
+		}
+		res.EndPos = lastEnd(tokens)
+	}
+	if res.Name == "time" || res.Name == "timestamp" {
+		// WITH TIME ZONE and WITHOUT TIME ZONE of PostgreSQL follow the precision.
+		if next := peekTokens(tokens, 3); len(next) == 3 && (isKeyword(next[0], "with") || isKeyword(next[0], "without")) &&
+			isKeyword(next[1], "time") && isKeyword(next[2], "zone") {
+			tokens.Discard(3)
+			res.Name += " " + keywordText(next[0]) + " time zone"
+			res.EndPos = next[2].End
+		}
+	}
+	if next := peekToken(tokens); isKeyword(next, "unsigned") {
+		tokens.Discard(1)
+		res.Unsigned = true
+		res.EndPos = next.End
+	}
+
+	return res, nil
+}
+
+func (p *Parser) parseTableConstraint(tokens TokenReader) (*TableConstraint, error) {
+	res := &TableConstraint{Span: Span{StartPos: peekToken(tokens).Position}}
+	if isKeyword(peekToken(tokens), "constraint") {
+		tokens.Discard(1)
+		name, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+		if err != nil {
+			return nil, err
+		}
+		res.Name = name.Value
+	}
+
+	t, err := tokens.Scan()
+	if err != nil {
+		return nil, p.unexpected(Token{Type: EOF}, PRIMARYKEY, UNIQUE, CHECK, INDEX)
+	}
+	switch {
+	case t.Type == PRIMARYKEY:
+		res.Type = ConstraintTypePrimaryKey
+	case t.Type == UNIQUE:
+		res.Type = ConstraintTypeUnique
+		if next := peekToken(tokens); next.Type == INDEX || isKeyword(next, "key") {
+			tokens.Discard(1)
+		}
+	case t.Type == INDEX || isKeyword(t, "key"):
+		res.Type = ConstraintTypeIndex
+	case isKeyword(t, "foreign"):
+		if _, err := p.expectKeyword(tokens, "key"); err != nil {
+			return nil, err
+		}
+		res.Type = ConstraintTypeForeignKey
+	case t.Type == CHECK:
+		check, err := p.parseCheck(tokens)
+		if err != nil {
+			return nil, err
+		}
+		res.Type = ConstraintTypeCheck
+		res.Check = check
+		res.EndPos = check.End()
+		return res, p.expectEnd(tokens)
+	default:
+		return nil, p.unexpected(t, PRIMARYKEY, UNIQUE, CHECK, INDEX)
+	}
+
+	// The index name of MySQL such as UNIQUE KEY uk_name (name)
+	if next := peekToken(tokens); next.Type == IDENT || next.Type == QUOTED_IDENT {
+		tokens.Discard(1)
+		res.Name = next.Value
+	}
+	columns, end, err := p.parseColumnList(tokens)
+	if err != nil {
+		return nil, err
+	}
+	res.Columns = columns
+	res.EndPos = end
+
+	if res.Type == ConstraintTypeForeignKey {
+		r, err := p.expect(tokens, REFERENCE)
+		if err != nil {
+			return nil, err
+		}
+		references, err := p.parseReferences(r, tokens)
+		if err != nil {
+			return nil, err
+		}
+		res.References = references
+		res.EndPos = references.EndPos
+	}
+
+	return res, p.expectEnd(tokens)
+}
+
+// parseColumnList parses a list of column names and returns the end of the closing parenthesis.
+func (p *Parser) parseColumnList(tokens TokenReader) ([]string, Position, error) {
+	res, err := p.parseIdentifierList(tokens)
+	if err != nil {
+		return nil, Position{}, err
+	}
+
+	return res, lastEnd(tokens), nil
+}
+
+// parseReferences parses the rest of REFERENCES. r is the REFERENCES token which is already consumed.
+func (p *Parser) parseReferences(r Token, tokens TokenReader) (*References, error) {
+	table, err := p.parseTableName(tokens)
+	if err != nil {
+		return nil, err
+	}
+	res := &References{Span: Span{StartPos: r.Position, EndPos: table.EndPos}, Table: table}
+
+	if peekToken(tokens).Type == LPAREN {
+		columns, end, err := p.parseColumnList(tokens)
+		if err != nil {
+			return nil, err
+		}
+		res.Columns = columns
+		res.EndPos = end
+	}
+
+	for {
+		t, err := tokens.Peek(2)
+		if err != nil || t[0].Type != ON || (t[1].Type != DELETE && t[1].Type != UPDATE) {
+			return res, nil
+		}
+		tokens.Discard(2)
+
+		action, end, err := p.parseReferentialAction(tokens)
+		if err != nil {
+			return nil, err
+		}
+		if t[1].Type == DELETE {
+			res.OnDelete = action
+		} else {
+			res.OnUpdate = action
+		}
+		res.EndPos = end
+	}
+}
+
+// parseReferentialAction parses CASCADE, RESTRICT, NO ACTION, SET NULL or SET DEFAULT.
+func (p *Parser) parseReferentialAction(tokens TokenReader) (string, Position, error) {
+	t := peekToken(tokens)
+	tokens.Discard(1)
+
+	switch {
+	case isKeyword(t, "cascade"), isKeyword(t, "restrict"):
+		return keywordText(t), t.End, nil
+	case isKeyword(t, "no"):
+		action, err := p.expectKeyword(tokens, "action")
+		if err != nil {
+			return "", Position{}, err
+		}
+		return "no action", action.End, nil
+	case t.Type == SET:
+		action, err := p.expect(tokens, NULL, DEFAULT)
+		if err != nil {
+			return "", Position{}, err
+		}
+		return "set " + keywordText(action), action.End, nil
+	}
+
+	return "", Position{}, p.newError(t, "unexpected "+describeToken(t)+", expected a referential action", nil)
+}
+
+// parseCheck parses the parenthesized search condition of CHECK.
+func (p *Parser) parseCheck(tokens TokenReader) (Expr, error) {
+	lparen, err := p.expect(tokens, LPAREN)
+	if err != nil {
+		return nil, err
+	}
+	cond := p.collect(tokens, func(Token) bool { return false })
+	rparen, err := p.expect(tokens, RPAREN)
+	if err != nil {
+		return nil, err
+	}
+	if len(cond) == 0 {
+		return nil, p.newError(rparen, "unexpected "+describeToken(rparen)+", expected a search condition", valueTokenTypes)
+	}
+
+	return p.parseBooleanTokens(cond, lparen.End)
+}
+
+// parseDefaultValue parses the value which follows DEFAULT.
+func (p *Parser) parseDefaultValue(tokens TokenReader) (Expr, error) {
+	operand, err := p.parseOperandTokens(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.parseExpr(removeRedundantParen(operand))
+}
+
+// parseOperandTokens reads the tokens of an operand: a literal, a signed number, a function call or a parenthesized expression.
+func (p *Parser) parseOperandTokens(tokens TokenReader) (Tokens, error) {
+	t := peekToken(tokens)
+	if t.Type == EOF {
+		return nil, p.unexpected(t, valueTokenTypes...)
+	}
+	tokens.Discard(1)
+	res := Tokens{t}
+
+	switch t.Type {
+	case MINUS, PLUS:
+		next := peekToken(tokens)
+		if next.Type == EOF {
+			return nil, p.unexpected(next, INT, DECIMAL, FLOAT)
+		}
+		tokens.Discard(1)
+		return append(res, next), nil
+	case IDENT:
+		if peekToken(tokens).Type != LPAREN {
+			return res, nil
+		}
+		lparen, _ := tokens.Scan()
+		res = append(res, lparen)
+		fallthrough
+	case LPAREN:
+		res = append(res, p.collect(tokens, func(Token) bool { return false })...)
+		rparen, err := p.expect(tokens, RPAREN)
+		if err != nil {
+			return nil, err
+		}
+		return append(res, rparen), nil
+	}
+
+	return res, nil
+}
+
+// lastEnd returns the end of the last token read from tokens which is a positionReader.
+// The elements of a parenthesized list are parsed with their own positionReader.
+func lastEnd(tokens TokenReader) Position {
+	if r, ok := tokens.(*positionReader); ok {
+		return r.last.End
+	}
+	return Position{}
+}
+
+// keywordText returns the lower case text of a keyword or an unquoted identifier.
+func keywordText(t Token) string {
+	if t.Text != "" {
+		return strings.ToLower(t.Text)
+	}
+	if t.Value != "" {
+		return strings.ToLower(t.Value)
+	}
+	return strings.ToLower(t.Type.String())
+}
@@ -0,0 +1,206 @@
+package parser
+
+// CreateDatabase
+// Query: CREATE DATABASE app
+//		  CREATE DATABASE IF NOT EXISTS app DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_bin
+//		  CREATE DATABASE app WITH OWNER = admin
+// <create database statement> ::= CREATE DATABASE [ IF NOT EXISTS ] <database name> [ <database option>... ]
+// <database option> ::=
+//		[ DEFAULT ] { CHARACTER SET | CHARSET } [ <equals operator> ] <character set name>
+//	|	[ DEFAULT ] COLLATE [ <equals operator> ] <collation name>
+//	|	[ WITH ] OWNER [ <equals operator> ] <role name>
+
+type CreateDatabase struct {
+	Span
+	Name         string
+	IfNotExists  bool
+	CharacterSet string
+	Collate      string
+	// Owner is the OWNER of PostgreSQL.
+	Owner string
+}
+
+// CreateSchema
+// Query: CREATE SCHEMA app
+//		  CREATE SCHEMA IF NOT EXISTS app AUTHORIZATION admin
+//		  CREATE SCHEMA AUTHORIZATION admin
+// <create schema statement> ::= CREATE SCHEMA [ IF NOT EXISTS ] <schema name clause> [ <database option>... ]
+// <schema name clause> ::= <schema name> [ AUTHORIZATION <role name> ] | AUTHORIZATION <role name>
+
+type CreateSchema struct {
+	Span
+	// Name is empty when it is omitted and the name of AUTHORIZATION is used as the schema name.
+	Name          string
+	IfNotExists   bool
+	Authorization string
+	// CharacterSet and Collate are the options of MySQL which treats SCHEMA as a synonym for DATABASE.
+	CharacterSet string
+	Collate      string
+}
+
+func (*CreateDatabase) statementNode() {}
+func (*CreateSchema) statementNode()   {}
+
+func (p *Parser) parseCreateDatabase(tokens TokenReader) (Statement, error) {
+	start, err := p.expect(tokens, CREATE)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens, DATABASE); err != nil {
+		return nil, err
+	}
+	query := &CreateDatabase{Span: Span{StartPos: start.Position}}
+
+	ifNotExists, err := p.parseIfNotExists(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.IfNotExists = ifNotExists
+
+	name, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+	if err != nil {
+		return nil, err
+	}
+	query.Name = name.Value
+
+	for {
+		t := peekToken(tokens)
+		if t.Type == DEFAULT || isKeyword(t, "with") {
+			tokens.Discard(1)
+			if err := p.expectDatabaseOption(tokens, t); err != nil {
+				return nil, err
+			}
+			t = peekToken(tokens)
+		}
+
+		var target *string
+		switch {
+		case isCharacterSet(tokens):
+			target = &query.CharacterSet
+		case isKeyword(t, "collate"):
+			target = &query.Collate
+		case isKeyword(t, "owner"):
+			target = &query.Owner
+		default:
+			if err := p.expectEnd(tokens); err != nil {
+				return nil, err
+			}
+			query.EndPos = p.reader.last.End
+			return query, nil
+		}
+
+		v, err := p.parseDatabaseOption(tokens)
+		if err != nil {
+			return nil, err
+		}
+		*target = v
+	}
+}
+
+func (p *Parser) parseCreateSchema(tokens TokenReader) (Statement, error) {
+	start, err := p.expect(tokens, CREATE)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expectKeyword(tokens, "schema"); err != nil {
+		return nil, err
+	}
+	query := &CreateSchema{Span: Span{StartPos: start.Position}}
+
+	ifNotExists, err := p.parseIfNotExists(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.IfNotExists = ifNotExists
+
+	if t := peekToken(tokens); !isKeyword(t, "authorization") {
+		name, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+		if err != nil {
+			return nil, err
+		}
+		query.Name = name.Value
+	}
+	if isKeyword(peekToken(tokens), "authorization") {
+		tokens.Discard(1)
+		role, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+		if err != nil {
+			return nil, err
+		}
+		query.Authorization = role.Value
+	}
+
+	for {
+		if t := peekToken(tokens); t.Type == DEFAULT {
+			tokens.Discard(1)
+			if err := p.expectDatabaseOption(tokens, t); err != nil {
+				return nil, err
+			}
+		}
+
+		var target *string
+		switch {
+		case isCharacterSet(tokens):
+			target = &query.CharacterSet
+		case isKeyword(peekToken(tokens), "collate"):
+			target = &query.Collate
+		default:
+			if err := p.expectEnd(tokens); err != nil {
+				return nil, err
+			}
+			query.EndPos = p.reader.last.End
+			return query, nil
+		}
+
+		v, err := p.parseDatabaseOption(tokens)
+		if err != nil {
+			return nil, err
+		}
+		*target = v
+	}
+}
+
+// isCharacterSet reports whether the next tokens are CHARACTER SET or CHARSET.
+func isCharacterSet(tokens TokenReader) bool {
+	t, err := tokens.Peek(2)
+	if err != nil {
+		return isKeyword(peekToken(tokens), "charset")
+	}
+
+	return isKeyword(t[0], "charset") || (isKeyword(t[0], "character") && t[1].Type == SET)
+}
+
+// expectDatabaseOption reports an error when the option which prefix modifies does not follow.
+// DEFAULT modifies CHARACTER SET, CHARSET or COLLATE and WITH modifies OWNER.
+func (p *Parser) expectDatabaseOption(tokens TokenReader, prefix Token) error {
+	t := peekToken(tokens)
+	if prefix.Type == DEFAULT {
+		if isCharacterSet(tokens) || isKeyword(t, "collate") {
+			return nil
+		}
+		return p.newError(t, "unexpected "+describeToken(t)+", expected CHARACTER SET, CHARSET or COLLATE", []TokenType{IDENT})
+	}
+	if isKeyword(t, "owner") {
+		return nil
+	}
+
+	return p.newError(t, "unexpected "+describeToken(t)+", expected OWNER", []TokenType{IDENT})
+}
+
+// parseDatabaseOption parses an option such as CHARACTER SET utf8mb4 and returns the value.
+func (p *Parser) parseDatabaseOption(tokens TokenReader) (string, error) {
+	if isKeyword(peekToken(tokens), "character") {
+		tokens.Discard(2)
+	} else {
+		tokens.Discard(1)
+	}
+	if peekToken(tokens).Type == EQUAL {
+		tokens.Discard(1)
+	}
+
+	v, err := p.expect(tokens, IDENT, QUOTED_IDENT, STRING)
+	if err != nil {
+		return "", err
+	}
+
+	return v.Value, nil
+}
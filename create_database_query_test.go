@@ -0,0 +1,54 @@
+package parser
+
+var TestCreateDatabaseQuery = []TestQuery{
+	{ // # 0
+		Query: "create database users",
+		Ast:   &CreateDatabase{Name: "users"},
+	},
+	{ // # 1
+		Query: "CREATE DATABASE IF NOT EXISTS app DEFAULT CHARACTER SET utf8mb4 COLLATE = utf8mb4_bin",
+		Ast:   &CreateDatabase{Name: "app", IfNotExists: true, CharacterSet: "utf8mb4", Collate: "utf8mb4_bin"},
+	},
+	{ // # 2
+		Query: "create database app with owner = admin charset 'utf8'",
+		Ast:   &CreateDatabase{Name: "app", Owner: "admin", CharacterSet: "utf8"},
+	},
+}
+
+var TestCreateSchemaQuery = []TestQuery{
+	{ // # 0
+		Query: "create schema app",
+		Ast:   &CreateSchema{Name: "app"},
+	},
+	{ // # 1
+		Query: "CREATE SCHEMA IF NOT EXISTS app AUTHORIZATION admin",
+		Ast:   &CreateSchema{Name: "app", IfNotExists: true, Authorization: "admin"},
+	},
+	{ // # 2
+		Query: "create schema authorization admin",
+		Ast:   &CreateSchema{Authorization: "admin"},
+	},
+	{ // # 3
+		Query: "create schema app default character set utf8mb4 collate utf8mb4_bin",
+		Ast:   &CreateSchema{Name: "app", CharacterSet: "utf8mb4", Collate: "utf8mb4_bin"},
+	},
+}
+
+var TestCreateDatabaseErrorQuery = []TestErrorQuery{
+	{ // # 0
+		Query:    "CREATE DATABASE d DEFAULT",
+		Position: Position{Line: 1, Offset: 25, Column: 26},
+	},
+	{ // # 1
+		Query:    "CREATE DATABASE d DEFAULT OWNER admin",
+		Position: Position{Line: 1, Offset: 26, Column: 27},
+	},
+	{ // # 2
+		Query:    "CREATE DATABASE d WITH COLLATE utf8mb4_bin",
+		Position: Position{Line: 1, Offset: 23, Column: 24},
+	},
+	{ // # 3
+		Query:    "CREATE SCHEMA s DEFAULT",
+		Position: Position{Line: 1, Offset: 23, Column: 24},
+	},
+}
@@ -0,0 +1,167 @@
+package parser
+
+import "strings"
+
+// CreateIndex
+// Query: CREATE INDEX idx_name ON users (name)
+//		  CREATE UNIQUE INDEX IF NOT EXISTS uk_email ON users (email(64) DESC, created_at ASC)
+//		  CREATE INDEX CONCURRENTLY idx_lower_email ON users USING btree (lower(email)) WHERE deleted_at IS NULL
+//		  CREATE INDEX ON users (name)
+// <create index statement> ::= CREATE [ UNIQUE ] INDEX [ CONCURRENTLY ] [ IF NOT EXISTS ]
+//		[ <index name> [ USING <index method> ] ] ON <table name> [ USING <index method> ]
+//		<left paren> <index key> [ { <comma> <index key> }... ] <right paren> [ WHERE <search condition> ]
+// <index key> ::= { <column name> [ <left paren> <prefix length> <right paren> ] | <function call> | <left paren> <value expression> <right paren> }
+//		[ ASC | DESC ]
+
+type CreateIndex struct {
+	Span
+	// Name is empty when the index name is omitted.
+	Name         string
+	Table        TableReference
+	Unique       bool
+	Concurrently bool
+	IfNotExists  bool
+	// Using is the index method in lower case such as btree, hash and gin. It is empty when the method is omitted.
+	Using   string
+	Columns []*IndexColumn
+	// Where is the predicate of a partial index. It is nil when the index is not partial.
+	Where Expr
+}
+
+// IndexColumn is a key of an index. Either Column or Expr is set.
+type IndexColumn struct {
+	Span
+	Column string
+	// Length is the prefix length of MySQL such as name(10). It is zero when the length is omitted.
+	Length int
+	// Expr is the expression of an expression index such as lower(email).
+	Expr Expr
+	Desc bool
+}
+
+func (*CreateIndex) statementNode() {}
+
+func (p *Parser) parseCreateIndex(tokens TokenReader) (Statement, error) {
+	start, err := p.expect(tokens, CREATE)
+	if err != nil {
+		return nil, err
+	}
+	query := &CreateIndex{Span: Span{StartPos: start.Position}}
+
+	if peekToken(tokens).Type == UNIQUE {
+		tokens.Discard(1)
+		query.Unique = true
+	}
+	if _, err := p.expect(tokens, INDEX); err != nil {
+		return nil, err
+	}
+	if isKeyword(peekToken(tokens), "concurrently") {
+		tokens.Discard(1)
+		query.Concurrently = true
+	}
+
+	ifNotExists, err := p.parseIfNotExists(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.IfNotExists = ifNotExists
+
+	// PostgreSQL generates the name when it is omitted.
+	if peekToken(tokens).Type != ON {
+		name, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+		if err != nil {
+			return nil, err
+		}
+		query.Name = name.Value
+	}
+
+	if query.Using, err = p.parseIndexMethod(tokens); err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokens, ON); err != nil {
+		return nil, err
+	}
+	table, err := p.parseTableName(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.Table = table
+	if query.Using == "" {
+		if query.Using, err = p.parseIndexMethod(tokens); err != nil {
+			return nil, err
+		}
+	}
+
+	keys, err := p.parseParenList(tokens)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		column, err := p.parseIndexColumn(key)
+		if err != nil {
+			return nil, err
+		}
+		query.Columns = append(query.Columns, column)
+	}
+
+	if peekToken(tokens).Type == WHERE {
+		where, err := p.parseWhereClause(tokens)
+		if err != nil {
+			return nil, err
+		}
+		query.Where = where.Cond
+	}
+
+	if err := p.expectEnd(tokens); err != nil {
+		return nil, err
+	}
+	query.EndPos = p.reader.last.End
+
+	return query, nil
+}
+
+// parseIndexMethod parses an optional USING and returns the method in lower case.
+func (p *Parser) parseIndexMethod(tokens TokenReader) (string, error) {
+	if !isKeyword(peekToken(tokens), "using") {
+		return "", nil
+	}
+	tokens.Discard(1)
+
+	method, err := p.expect(tokens, IDENT)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToLower(method.Value), nil
+}
+
+func (p *Parser) parseIndexColumn(tokens Tokens) (*IndexColumn, error) {
+	res := &IndexColumn{Span: tokensSpan(tokens)}
+	if last := tokens[len(tokens)-1]; last.Type == ASC || last.Type == DESC {
+		res.Desc = last.Type == DESC
+		tokens = tokens[:len(tokens)-1]
+		if len(tokens) == 0 {
+			return nil, p.unexpected(last, IDENT, QUOTED_IDENT, LPAREN)
+		}
+	}
+
+	switch {
+	case len(tokens) == 1 && (tokens[0].Type == IDENT || tokens[0].Type == QUOTED_IDENT):
+		res.Column = tokens[0].Value
+		return res, nil
+	case len(tokens) == 4 && tokens[0].Type == IDENT && tokens[1].Type == LPAREN && tokens[2].Type == INT && tokens[3].Type == RPAREN:
+		res.Column = tokens[0].Value
+		res.Length = tokens[2].IntValue
+		return res, nil
+	case tokens[0].Type == LPAREN:
+		tokens = removeRedundantParen(tokens)
+	}
+
+	expr, err := p.parseExpr(tokens)
+	if err != nil {
+		return nil, err
+	}
+	res.Expr = expr
+
+	return res, nil
+}
@@ -0,0 +1,88 @@
+package parser
+
+var TestCreateIndexQuery = []TestQuery{
+	{ // # 0
+		Query: "create index idx_name on users (name)",
+		Ast: &CreateIndex{
+			Name:    "idx_name",
+			Table:   TableReference{Name: "users"},
+			Columns: []*IndexColumn{{Column: "name"}},
+		},
+	},
+	{ // # 1
+		Query: "CREATE UNIQUE INDEX IF NOT EXISTS uk_email ON users (email(64) DESC, created_at ASC)",
+		Ast: &CreateIndex{
+			Name:        "uk_email",
+			Table:       TableReference{Name: "users"},
+			Unique:      true,
+			IfNotExists: true,
+			Columns:     []*IndexColumn{{Column: "email", Length: 64, Desc: true}, {Column: "created_at"}},
+		},
+	},
+	{ // # 2
+		Query: "create index concurrently idx_lower_email on users using gin (lower(email), (id)) where deleted = 0",
+		Ast: &CreateIndex{
+			Name:         "idx_lower_email",
+			Table:        TableReference{Name: "users"},
+			Concurrently: true,
+			Using:        "gin",
+			Columns: []*IndexColumn{
+				{Expr: &FuncCall{Name: "lower", Args: []Expr{ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"email"}}}}},
+				{Expr: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"id"}}},
+			},
+			Where: &ComparisonExpr{
+				Operator:   ComparisonOperatorEqual,
+				LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"deleted"}},
+				RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 0},
+			},
+		},
+	},
+	{ // # 3
+		Query: "create index idx_age using btree on users (age)",
+		Ast: &CreateIndex{
+			Name:    "idx_age",
+			Table:   TableReference{Name: "users"},
+			Using:   "btree",
+			Columns: []*IndexColumn{{Column: "age"}},
+		},
+	},
+	{ // # 4
+		Query: "CREATE INDEX CONCURRENTLY idx_lower_email ON users USING btree (lower(email)) WHERE deleted_at IS NULL",
+		Ast: &CreateIndex{
+			Name:         "idx_lower_email",
+			Table:        TableReference{Name: "users"},
+			Concurrently: true,
+			Using:        "btree",
+			Columns: []*IndexColumn{
+				{Expr: &FuncCall{Name: "lower", Args: []Expr{ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"email"}}}}},
+			},
+			Where: &NullPredicate{Value: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"deleted_at"}}},
+		},
+	},
+	{ // # 5
+		Query: "create index idx_email on users (email) where email is not null",
+		Ast: &CreateIndex{
+			Name:    "idx_email",
+			Table:   TableReference{Name: "users"},
+			Columns: []*IndexColumn{{Column: "email"}},
+			Where:   &NullPredicate{Value: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"email"}}, Not: true},
+		},
+	},
+	{ // # 6
+		Query: "CREATE INDEX ON users (a)",
+		Ast: &CreateIndex{
+			Table:   TableReference{Name: "users"},
+			Columns: []*IndexColumn{{Column: "a"}},
+		},
+	},
+	{ // # 7
+		Query: "create unique index if not exists on users using hash (a, b desc)",
+		Ast: &CreateIndex{
+			Table:       TableReference{Name: "users"},
+			Unique:      true,
+			IfNotExists: true,
+			Using:       "hash",
+			Columns:     []*IndexColumn{{Column: "a"}, {Column: "b", Desc: true}},
+		},
+	},
+}
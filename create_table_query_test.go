@@ -0,0 +1,106 @@
+package parser
+
+var TestCreateTableQuery = []TestQuery{
+	{ // # 0
+		Query: "create table users (id int)",
+		Ast: &CreateTable{
+			Table:   TableReference{Name: "users"},
+			Columns: []*ColumnDefinition{{Name: "id", Type: DataType{Name: "int"}}},
+		},
+	},
+	{ // # 1
+		Query: "CREATE TABLE IF NOT EXISTS users (id serial PRIMARY KEY, name varchar(255) NOT NULL DEFAULT '', price decimal(10, 2) unsigned DEFAULT 0)",
+		Ast: &CreateTable{
+			Table:       TableReference{Name: "users"},
+			IfNotExists: true,
+			Columns: []*ColumnDefinition{
+				{Name: "id", Type: DataType{Name: "serial"}, PrimaryKey: true},
+				{Name: "name", Type: DataType{Name: "varchar", Args: []int{255}}, NotNull: true, Default: ValueExpr{Type: ValueTypeString}},
+				{Name: "price", Type: DataType{Name: "decimal", Args: []int{10, 2}, Unsigned: true}, Default: ValueExpr{Type: ValueTypeInt}},
+			},
+		},
+	},
+	{ // # 2
+		Query: "create table blog (id int not null auto_increment, user_id int references users (id) on delete cascade, created_at timestamp default now(), " +
+			"unique key uk_user (user_id), primary key (id), constraint fk_user foreign key (user_id) references users (id) on update set null, check (id > 0))",
+		Ast: &CreateTable{
+			Table: TableReference{Name: "blog"},
+			Columns: []*ColumnDefinition{
+				{Name: "id", Type: DataType{Name: "int"}, NotNull: true, AutoIncrement: true},
+				{Name: "user_id", Type: DataType{Name: "int"}, References: &References{Table: TableReference{Name: "users"}, Columns: []string{"id"}, OnDelete: "cascade"}},
+				{Name: "created_at", Type: DataType{Name: "timestamp"}, Default: &FuncCall{Name: "now"}},
+			},
+			Constraints: []*TableConstraint{
+				{Name: "uk_user", Type: ConstraintTypeUnique, Columns: []string{"user_id"}},
+				{Type: ConstraintTypePrimaryKey, Columns: []string{"id"}},
+				{Name: "fk_user", Type: ConstraintTypeForeignKey, Columns: []string{"user_id"},
+					References: &References{Table: TableReference{Name: "users"}, Columns: []string{"id"}, OnUpdate: "set null"}},
+				{Type: ConstraintTypeCheck, Check: &ComparisonExpr{
+					Operator:   ComparisonOperatorGreaterThan,
+					LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"id"}},
+					RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 0},
+				}},
+			},
+		},
+	},
+	{ // # 3
+		Query: "CREATE TABLE kv (key varchar(10), value text, amount decimal(10, 2), key idx_value (value), key (amount))",
+		Ast: &CreateTable{
+			Table: TableReference{Name: "kv"},
+			Columns: []*ColumnDefinition{
+				{Name: "key", Type: DataType{Name: "varchar", Args: []int{10}}},
+				{Name: "value", Type: DataType{Name: "text"}},
+				{Name: "amount", Type: DataType{Name: "decimal", Args: []int{10, 2}}},
+			},
+			Constraints: []*TableConstraint{
+				{Name: "idx_value", Type: ConstraintTypeIndex, Columns: []string{"value"}},
+				{Type: ConstraintTypeIndex, Columns: []string{"amount"}},
+			},
+		},
+	},
+	{ // # 4
+		Query: "CREATE TABLE events (ratio double precision, label character varying(10), kind enum('a', 'b'), " +
+			"created_at timestamp with time zone, updated_at timestamp(3) without time zone)",
+		Ast: &CreateTable{
+			Table: TableReference{Name: "events"},
+			Columns: []*ColumnDefinition{
+				{Name: "ratio", Type: DataType{Name: "double precision"}},
+				{Name: "label", Type: DataType{Name: "character varying", Args: []int{10}}},
+				{Name: "kind", Type: DataType{Name: "enum", Values: []string{"a", "b"}}},
+				{Name: "created_at", Type: DataType{Name: "timestamp with time zone"}},
+				{Name: "updated_at", Type: DataType{Name: "timestamp without time zone", Args: []int{3}}},
+			},
+		},
+	},
+}
+
+var TestCreateTableErrorQuery = []TestErrorQuery{
+	{ // # 0
+		Query:    "CREATE TABLE t (a int, PRIMARY KEY (a b c))",
+		Position: Position{Line: 1, Offset: 38, Column: 39},
+	},
+	{ // # 1
+		Query:    "CREATE TABLE t (a)",
+		Position: Position{Line: 1, Offset: 17, Column: 18},
+	},
+	{ // # 2
+		Query:    "CREATE TABLE t (a int DEFAULT, b int)",
+		Position: Position{Line: 1, Offset: 29, Column: 30},
+	},
+	{ // # 3
+		Query:    "CREATE TABLE t (a int CHECK)",
+		Position: Position{Line: 1, Offset: 27, Column: 28},
+	},
+	{ // # 4
+		Query:    "CREATE TABLE t (CONSTRAINT c)",
+		Position: Position{Line: 1, Offset: 28, Column: 29},
+	},
+	{ // # 5
+		Query:    "CREATE TABLE t (a int REFERENCES u ON DELETE)",
+		Position: Position{Line: 1, Offset: 44, Column: 45},
+	},
+	{ // # 6
+		Query:    "CREATE TABLE t (a enum('a', b))",
+		Position: Position{Line: 1, Offset: 28, Column: 29},
+	},
+}
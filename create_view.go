@@ -0,0 +1,219 @@
+package parser
+
+// CreateView
+// Query: CREATE VIEW active_users AS SELECT * FROM users WHERE active = 1
+//		  CREATE OR REPLACE VIEW user_names (id, name) AS SELECT id, name FROM users WITH LOCAL CHECK OPTION
+// <view definition> ::= CREATE [ OR REPLACE ] VIEW <table name> [ <left paren> <view column list> <right paren> ]
+//		AS <query expression> [ WITH [ CASCADED | LOCAL ] CHECK OPTION ]
+
+type CreateView struct {
+	Span
+	Name      TableReference
+	OrReplace bool
+	Columns   []string
+	Select    *Select
+	// WithCheckOption is true for WITH CHECK OPTION. LocalCheckOption is true when the option is LOCAL instead of CASCADED.
+	WithCheckOption  bool
+	LocalCheckOption bool
+}
+
+// CreateMaterializedView
+// Query: CREATE MATERIALIZED VIEW user_counts AS SELECT count(*) FROM users
+//		  CREATE MATERIALIZED VIEW IF NOT EXISTS user_counts (n) AS SELECT count(*) FROM users WITH NO DATA
+// <create materialized view statement> ::= CREATE MATERIALIZED VIEW [ IF NOT EXISTS ] <table name>
+//		[ <left paren> <view column list> <right paren> ] AS <query expression> [ WITH [ NO ] DATA ]
+
+type CreateMaterializedView struct {
+	Span
+	Name        TableReference
+	IfNotExists bool
+	Columns     []string
+	Select      *Select
+	WithNoData  bool
+}
+
+// RefreshMaterializedView
+// Query: REFRESH MATERIALIZED VIEW user_counts
+//		  REFRESH MATERIALIZED VIEW CONCURRENTLY user_counts WITH DATA
+// <refresh materialized view statement> ::= REFRESH MATERIALIZED VIEW [ CONCURRENTLY ] <table name> [ WITH [ NO ] DATA ]
+
+type RefreshMaterializedView struct {
+	Span
+	Name         TableReference
+	Concurrently bool
+	WithNoData   bool
+}
+
+func (*CreateView) statementNode()              {}
+func (*CreateMaterializedView) statementNode()  {}
+func (*RefreshMaterializedView) statementNode() {}
+
+func (p *Parser) parseCreateView(tokens TokenReader) (Statement, error) {
+	start, err := p.expect(tokens, CREATE)
+	if err != nil {
+		return nil, err
+	}
+	query := &CreateView{Span: Span{StartPos: start.Position}}
+
+	if peekToken(tokens).Type == OR {
+		tokens.Discard(1)
+		if _, err := p.expectKeyword(tokens, "replace"); err != nil {
+			return nil, err
+		}
+		query.OrReplace = true
+	}
+	if _, err := p.expectKeyword(tokens, "view"); err != nil {
+		return nil, err
+	}
+
+	name, columns, s, err := p.parseViewDefinition(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.Name, query.Columns, query.Select = name, columns, s
+
+	if isKeyword(peekToken(tokens), "with") {
+		tokens.Discard(1)
+		if t := peekToken(tokens); isKeyword(t, "local") || isKeyword(t, "cascaded") {
+			tokens.Discard(1)
+			query.LocalCheckOption = isKeyword(t, "local")
+		}
+		if _, err := p.expect(tokens, CHECK); err != nil {
+			return nil, err
+		}
+		if _, err := p.expectKeyword(tokens, "option"); err != nil {
+			return nil, err
+		}
+		query.WithCheckOption = true
+	}
+
+	if err := p.expectEnd(tokens); err != nil {
+		return nil, err
+	}
+	query.EndPos = p.reader.last.End
+
+	return query, nil
+}
+
+func (p *Parser) parseCreateMaterializedView(tokens TokenReader) (Statement, error) {
+	start, err := p.expect(tokens, CREATE)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expectKeyword(tokens, "materialized"); err != nil {
+		return nil, err
+	}
+	if _, err := p.expectKeyword(tokens, "view"); err != nil {
+		return nil, err
+	}
+	query := &CreateMaterializedView{Span: Span{StartPos: start.Position}}
+
+	ifNotExists, err := p.parseIfNotExists(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.IfNotExists = ifNotExists
+
+	name, columns, s, err := p.parseViewDefinition(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.Name, query.Columns, query.Select = name, columns, s
+
+	if query.WithNoData, err = p.parseWithData(tokens); err != nil {
+		return nil, err
+	}
+
+	if err := p.expectEnd(tokens); err != nil {
+		return nil, err
+	}
+	query.EndPos = p.reader.last.End
+
+	return query, nil
+}
+
+func (p *Parser) parseRefreshMaterializedView(tokens TokenReader) (Statement, error) {
+	start, err := p.expectKeyword(tokens, "refresh")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expectKeyword(tokens, "materialized"); err != nil {
+		return nil, err
+	}
+	if _, err := p.expectKeyword(tokens, "view"); err != nil {
+		return nil, err
+	}
+	query := &RefreshMaterializedView{Span: Span{StartPos: start.Position}}
+
+	if isKeyword(peekToken(tokens), "concurrently") {
+		tokens.Discard(1)
+		query.Concurrently = true
+	}
+	name, err := p.parseTableName(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.Name = name
+
+	if query.WithNoData, err = p.parseWithData(tokens); err != nil {
+		return nil, err
+	}
+
+	if err := p.expectEnd(tokens); err != nil {
+		return nil, err
+	}
+	query.EndPos = p.reader.last.End
+
+	return query, nil
+}
+
+// parseViewDefinition parses the name, the optional column list and the query which follows AS.
+// The query ends at WITH which begins the options after the query.
+func (p *Parser) parseViewDefinition(tokens TokenReader) (TableReference, []string, *Select, error) {
+	name, err := p.parseTableName(tokens)
+	if err != nil {
+		return TableReference{}, nil, nil, err
+	}
+
+	var columns []string
+	if peekToken(tokens).Type == LPAREN {
+		if columns, err = p.parseIdentifierList(tokens); err != nil {
+			return TableReference{}, nil, nil, err
+		}
+	}
+
+	if _, err := p.expect(tokens, AS); err != nil {
+		return TableReference{}, nil, nil, err
+	}
+	body := p.collect(tokens, func(t Token) bool { return isKeyword(t, "with") })
+	next := peekToken(tokens)
+	r := &positionReader{TokenReader: NewTokensReader(append(body, Token{Type: EOF, Position: next.Position, End: next.Position}))}
+	s, err := p.parseSelect(r)
+	if err != nil {
+		return TableReference{}, nil, nil, err
+	}
+	if err := p.expectEnd(r); err != nil {
+		return TableReference{}, nil, nil, err
+	}
+
+	return name, columns, s.(*Select), nil
+}
+
+// parseWithData parses an optional WITH DATA or WITH NO DATA and reports whether it is WITH NO DATA.
+func (p *Parser) parseWithData(tokens TokenReader) (bool, error) {
+	if !isKeyword(peekToken(tokens), "with") {
+		return false, nil
+	}
+	tokens.Discard(1)
+
+	noData := false
+	if isKeyword(peekToken(tokens), "no") {
+		tokens.Discard(1)
+		noData = true
+	}
+	if _, err := p.expectKeyword(tokens, "data"); err != nil {
+		return false, err
+	}
+
+	return noData, nil
+}
@@ -0,0 +1,146 @@
+package parser
+
+var TestCreateViewQuery = []TestQuery{
+	{ // # 0
+		Query: "create view active_users as select * from users where active = 1",
+		Ast: &CreateView{
+			Name: TableReference{Name: "active_users"},
+			Select: &Select{
+				SelectList: []SelectExpr{{Asterisk: true}},
+				Table: TableExpression{
+					From: FromClause{Table: []TableReference{{Name: "users"}}},
+					Where: WhereClause{Cond: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"active"}},
+						RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 1},
+					}},
+				},
+			},
+		},
+	},
+	{ // # 1
+		Query: "CREATE OR REPLACE VIEW user_names (id, name) AS SELECT id, name FROM users WITH LOCAL CHECK OPTION",
+		Ast: &CreateView{
+			Name:      TableReference{Name: "user_names"},
+			OrReplace: true,
+			Columns:   []string{"id", "name"},
+			Select: &Select{
+				SelectList: []SelectExpr{{Column: "id"}, {Column: "name"}},
+				Table:      TableExpression{From: FromClause{Table: []TableReference{{Name: "users"}}}},
+			},
+			WithCheckOption:  true,
+			LocalCheckOption: true,
+		},
+	},
+	{ // # 2
+		Query: "create view user_ids as select id from users with check option",
+		Ast: &CreateView{
+			Name: TableReference{Name: "user_ids"},
+			Select: &Select{
+				SelectList: []SelectExpr{{Column: "id"}},
+				Table:      TableExpression{From: FromClause{Table: []TableReference{{Name: "users"}}}},
+			},
+			WithCheckOption: true,
+		},
+	},
+	{ // # 3
+		Query: "CREATE VIEW user_stats AS SELECT count(*) AS \"user count\", concat(first_name, last_name) AS full_name, u.* FROM users u",
+		Ast: &CreateView{
+			Name: TableReference{Name: "user_stats"},
+			Select: &Select{
+				SelectList: []SelectExpr{
+					{Expr: &FuncCall{Name: "count", Args: []Expr{&RawValue{Token: Token{Type: ASTERISK}}}}, Alias: "user count"},
+					{Expr: &FuncCall{Name: "concat", Args: []Expr{
+						ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"first_name"}},
+						ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"last_name"}},
+					}}, Alias: "full_name"},
+					{Asterisk: true, Qualifier: []string{"u"}},
+				},
+				Table: TableExpression{From: FromClause{Table: []TableReference{{Name: "users", Alias: "u"}}}},
+			},
+		},
+	},
+	{ // # 4
+		Query: "create view recent_users as select id from users order by id desc limit 10",
+		Ast: &CreateView{
+			Name: TableReference{Name: "recent_users"},
+			Select: &Select{
+				SelectList: []SelectExpr{{Column: "id"}},
+				Table:      TableExpression{From: FromClause{Table: []TableReference{{Name: "users"}}}},
+				OrderBy:    OrderByClause{{Key: Token{Type: IDENT, Value: "id"}, Order: Token{Type: DESC}}},
+				Limit:      ValueExpr{Type: ValueTypeInt, IntValue: 10},
+			},
+		},
+	},
+}
+
+var TestCreateMaterializedViewQuery = []TestQuery{
+	{ // # 0
+		Query: "CREATE MATERIALIZED VIEW user_counts (n) AS SELECT count(*) FROM users",
+		Ast: &CreateMaterializedView{
+			Name:    TableReference{Name: "user_counts"},
+			Columns: []string{"n"},
+			Select: &Select{
+				SelectList: []SelectExpr{{Expr: &FuncCall{Name: "count", Args: []Expr{&RawValue{Token: Token{Type: ASTERISK}}}}}},
+				Table:      TableExpression{From: FromClause{Table: []TableReference{{Name: "users"}}}},
+			},
+		},
+	},
+	{ // # 1
+		Query: "create materialized view user_ids as select id from users",
+		Ast: &CreateMaterializedView{
+			Name: TableReference{Name: "user_ids"},
+			Select: &Select{
+				SelectList: []SelectExpr{{Column: "id"}},
+				Table:      TableExpression{From: FromClause{Table: []TableReference{{Name: "users"}}}},
+			},
+		},
+	},
+	{ // # 2
+		Query: "CREATE MATERIALIZED VIEW IF NOT EXISTS user_ids (user_id) AS SELECT id FROM users WITH NO DATA",
+		Ast: &CreateMaterializedView{
+			Name:        TableReference{Name: "user_ids"},
+			IfNotExists: true,
+			Columns:     []string{"user_id"},
+			Select: &Select{
+				SelectList: []SelectExpr{{Column: "id"}},
+				Table:      TableExpression{From: FromClause{Table: []TableReference{{Name: "users"}}}},
+			},
+			WithNoData: true,
+		},
+	},
+	{ // # 3
+		Query: "create materialized view first_users as select id from users limit 10 with no data",
+		Ast: &CreateMaterializedView{
+			Name: TableReference{Name: "first_users"},
+			Select: &Select{
+				SelectList: []SelectExpr{{Column: "id"}},
+				Table:      TableExpression{From: FromClause{Table: []TableReference{{Name: "users"}}}},
+				Limit:      ValueExpr{Type: ValueTypeInt, IntValue: 10},
+			},
+			WithNoData: true,
+		},
+	},
+}
+
+var TestRefreshMaterializedViewQuery = []TestQuery{
+	{ // # 0
+		Query: "refresh materialized view user_ids",
+		Ast:   &RefreshMaterializedView{Name: TableReference{Name: "user_ids"}},
+	},
+	{ // # 1
+		Query: "REFRESH MATERIALIZED VIEW CONCURRENTLY user_ids WITH NO DATA",
+		Ast:   &RefreshMaterializedView{Name: TableReference{Name: "user_ids"}, Concurrently: true, WithNoData: true},
+	},
+}
+
+var TestCreateViewErrorQuery = []TestErrorQuery{
+	{ // # 0
+		Query:    "CREATE VIEW v AS SELECT * FROM t t2 t3 t4",
+		Position: Position{Line: 1, Offset: 36, Column: 37},
+	},
+	{ // # 1
+		Query:    "CREATE VIEW v AS SELECT count(*) AS FROM t",
+		Position: Position{Line: 1, Offset: 24, Column: 25},
+	},
+}
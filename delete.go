@@ -0,0 +1,116 @@
+package parser
+
+// Delete
+// Query: DELETE FROM users WHERE id = 1
+//		  DELETE FROM users WHERE created_at < ? ORDER BY id LIMIT 100
+//		  DELETE u FROM users u JOIN blog b ON u.id = b.user_id WHERE b.id > 10
+//		  DELETE FROM users USING blog WHERE users.id = blog.user_id RETURNING id
+// <delete statement> ::= DELETE [ <target table list> ] FROM <table reference list> [ <joined table> ]
+//		[ USING <table reference list> [ <joined table> ] ] [ <where clause> ] [ <order by clause> ] [ <limit clause> ]
+//		[ RETURNING <select list> ]
+
+type Delete struct {
+	Span
+	// Targets is the tables to delete rows from in the MySQL multi-table form such as DELETE t1, t2 FROM ...
+	Targets TableList
+	From    FromClause
+	// Using is the USING clause of PostgreSQL and MySQL.
+	Using   FromClause
+	Where   WhereClause
+	OrderBy OrderByClause
+	// Limit is nil when the statement has no LIMIT.
+	Limit     Expr
+	Returning SelectList
+}
+
+func (*Delete) statementNode() {}
+
+func (p *Parser) parseDelete(tokens TokenReader) (Statement, error) {
+	start, err := p.expect(tokens, DELETE)
+	if err != nil {
+		return nil, err
+	}
+	query := &Delete{Span: Span{StartPos: start.Position}}
+
+	if t := peekToken(tokens); t.Type != FROM {
+		targets, err := p.parseTableList(tokens)
+		if err != nil {
+			return nil, err
+		}
+		query.Targets = targets
+	}
+
+	from, err := p.parseFromClause(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.From = from
+
+	if t := peekToken(tokens); isKeyword(t, "using") {
+		tokens.Discard(1)
+		using, err := p.parseTableReferences(tokens)
+		if err != nil {
+			return nil, err
+		}
+		query.Using = using
+	}
+
+	if t := peekToken(tokens); t.Type == WHERE {
+		where, err := p.parseWhereClause(tokens)
+		if err != nil {
+			return nil, err
+		}
+		query.Where = where
+	}
+
+	orderBy, err := p.parseOrderByClause(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.OrderBy = orderBy
+
+	limit, err := p.parseLimitClause(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.Limit = limit
+
+	if t := peekToken(tokens); isKeyword(t, "returning") {
+		tokens.Discard(1)
+		returning, err := p.parseReturning(t, tokens)
+		if err != nil {
+			return nil, err
+		}
+		query.Returning = returning
+	}
+
+	if err := p.expectEnd(tokens); err != nil {
+		return nil, err
+	}
+	query.EndPos = p.reader.last.End
+
+	return query, nil
+}
+
+// parseReturning parses the select list which follows the RETURNING token returning.
+func (p *Parser) parseReturning(returning Token, tokens TokenReader) (SelectList, error) {
+	list := p.collect(tokens, func(Token) bool { return false })
+	if len(list) == 0 {
+		return nil, p.unexpected(peekToken(tokens), IDENT, ASTERISK)
+	}
+	elements, err := p.splitElements(returning, list)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(SelectList, 0)
+	for _, e := range elements {
+		s, err := p.parseSelectExpr(e)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, s)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,95 @@
+package parser
+
+var TestDeleteQuery = []TestQuery{
+	{ // # 0
+		Query: "delete from users where id = 1",
+		Ast: &Delete{
+			From: FromClause{Table: TableList{{Name: "users"}}},
+			Where: WhereClause{Cond: &ComparisonExpr{
+				Operator:   ComparisonOperatorEqual,
+				LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"id"}},
+				RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 1},
+			}},
+		},
+	},
+	{ // # 1
+		Query: "DELETE FROM users WHERE id < ? ORDER BY id LIMIT 100",
+		Ast: &Delete{
+			From: FromClause{Table: TableList{{Name: "users"}}},
+			Where: WhereClause{Cond: &ComparisonExpr{
+				Operator:   ComparisonOperatorLessThan,
+				LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"id"}},
+				RightValue: ValueExpr{Type: ValueTypeDynamicParameter, ParameterIndex: 1},
+			}},
+			OrderBy: OrderByClause{{Key: Token{Type: IDENT, Value: "id"}}},
+			Limit:   ValueExpr{Type: ValueTypeInt, IntValue: 100},
+		},
+	},
+	{ // # 2
+		Query: "delete u from users u join blog b on u.id = b.user_id where b.id > 10",
+		Ast: &Delete{
+			Targets: TableList{{Name: "u"}},
+			From: FromClause{
+				Table: TableList{{Name: "users", Alias: "u"}},
+				Join: []JoinedTable{{
+					Table: TableList{{Name: "blog", Alias: "b"}},
+					Cond: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"u", "id"}},
+						RightValue: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"b", "user_id"}},
+					},
+				}},
+			},
+			Where: WhereClause{Cond: &ComparisonExpr{
+				Operator:   ComparisonOperatorGreaterThan,
+				LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"b", "id"}},
+				RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 10},
+			}},
+		},
+	},
+	{ // # 3
+		Query: "delete from users using blog where users.id = blog.user_id returning id, name as n",
+		Ast: &Delete{
+			From:  FromClause{Table: TableList{{Name: "users"}}},
+			Using: FromClause{Table: TableList{{Name: "blog"}}},
+			Where: WhereClause{Cond: &ComparisonExpr{
+				Operator:   ComparisonOperatorEqual,
+				LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"users", "id"}},
+				RightValue: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"blog", "user_id"}},
+			}},
+			Returning: SelectList{{Column: "id"}, {Column: "name", Alias: "n"}},
+		},
+	},
+	{ // # 4
+		Query: "delete t1, t2 from t1 inner join t2 on t1.id = t2.id",
+		Ast: &Delete{
+			Targets: TableList{{Name: "t1"}, {Name: "t2"}},
+			From: FromClause{
+				Table: TableList{{Name: "t1"}},
+				Join: []JoinedTable{{
+					Table: TableList{{Name: "t2"}},
+					Cond: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"t1", "id"}},
+						RightValue: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"t2", "id"}},
+					},
+				}},
+			},
+		},
+	},
+}
+
+var TestDeleteErrorQuery = []TestErrorQuery{
+	{ // # 0
+		Query:    "DELETE FROM t RETURNING ,a",
+		Position: Position{Line: 1, Offset: 14, Column: 15},
+	},
+	{ // # 1
+		Query:    "DELETE FROM t RETURNING a,,b",
+		Position: Position{Line: 1, Offset: 24, Column: 25},
+	},
+	{ // # 2
+		Query:    "DELETE FROM t RETURNING a,",
+		Position: Position{Line: 1, Offset: 24, Column: 25},
+	},
+}
@@ -0,0 +1,173 @@
+package parser
+
+// Drop
+// Query: DROP TABLE users
+//		  DROP TABLE IF EXISTS users, blog CASCADE
+//		  DROP INDEX idx_name ON users
+//		  DROP VIEW IF EXISTS active_users RESTRICT
+// <drop statement> ::= DROP <object type> [ IF EXISTS ] <object name> [ { <comma> <object name> }... ]
+//		[ ON <table name> ] [ <drop behavior> ]
+// <object type> ::= DATABASE | TABLE | INDEX | VIEW | SEQUENCE
+// <drop behavior> ::= CASCADE | RESTRICT
+
+type Drop struct {
+	Span
+	Type     DropObjectType
+	IfExists bool
+	Names    TableList
+	// Table is the table of the MySQL form such as DROP INDEX idx_name ON users.
+	Table    TableReference
+	Cascade  bool
+	Restrict bool
+}
+
+const (
+	DropObjectDatabase = iota
+	DropObjectTable
+	DropObjectIndex
+	DropObjectView
+	DropObjectSequence
+)
+
+type DropObjectType int
+
+// Truncate
+// Query: TRUNCATE TABLE users
+//		  TRUNCATE users, blog RESTART IDENTITY CASCADE
+// <truncate statement> ::= TRUNCATE [ TABLE ] <table name> [ { <comma> <table name> }... ]
+//		[ { RESTART | CONTINUE } IDENTITY ] [ <drop behavior> ]
+
+type Truncate struct {
+	Span
+	Tables          TableList
+	RestartIdentity bool
+	Cascade         bool
+	Restrict        bool
+}
+
+func (*Drop) statementNode()     {}
+func (*Truncate) statementNode() {}
+
+func (p *Parser) parseDrop(tokens TokenReader) (Statement, error) {
+	start, err := p.expect(tokens, DROP)
+	if err != nil {
+		return nil, err
+	}
+	query := &Drop{Span: Span{StartPos: start.Position}}
+
+	t, err := tokens.Scan()
+	if err != nil {
+		return nil, p.unexpected(Token{Type: EOF}, DATABASE, TABLE, INDEX, IDENT)
+	}
+	switch {
+	case t.Type == DATABASE:
+		query.Type = DropObjectDatabase
+	case t.Type == TABLE:
+		query.Type = DropObjectTable
+	case t.Type == INDEX:
+		query.Type = DropObjectIndex
+	case isKeyword(t, "view"):
+		query.Type = DropObjectView
+	case isKeyword(t, "sequence"):
+		query.Type = DropObjectSequence
+	default:
+		return nil, p.newError(t, "unexpected "+describeToken(t)+", expected DATABASE, TABLE, INDEX, VIEW or SEQUENCE", []TokenType{DATABASE, TABLE, INDEX, IDENT})
+	}
+
+	ifExists, err := p.parseIfExists(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.IfExists = ifExists
+
+	names, err := p.parseTableNames(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.Names = names
+
+	if query.Type == DropObjectIndex && peekToken(tokens).Type == ON {
+		tokens.Discard(1)
+		table, err := p.parseTableName(tokens)
+		if err != nil {
+			return nil, err
+		}
+		query.Table = table
+	}
+
+	query.Cascade, query.Restrict = p.parseDropBehavior(tokens)
+
+	if err := p.expectEnd(tokens); err != nil {
+		return nil, err
+	}
+	query.EndPos = p.reader.last.End
+
+	return query, nil
+}
+
+func (p *Parser) parseTruncate(tokens TokenReader) (Statement, error) {
+	start, err := p.expectKeyword(tokens, "truncate")
+	if err != nil {
+		return nil, err
+	}
+	query := &Truncate{Span: Span{StartPos: start.Position}}
+
+	if peekToken(tokens).Type == TABLE {
+		tokens.Discard(1)
+	}
+	tables, err := p.parseTableNames(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.Tables = tables
+
+	if t := peekToken(tokens); isKeyword(t, "restart") || isKeyword(t, "continue") {
+		tokens.Discard(1)
+		if _, err := p.expectKeyword(tokens, "identity"); err != nil {
+			return nil, err
+		}
+		query.RestartIdentity = isKeyword(t, "restart")
+	}
+
+	query.Cascade, query.Restrict = p.parseDropBehavior(tokens)
+
+	if err := p.expectEnd(tokens); err != nil {
+		return nil, err
+	}
+	query.EndPos = p.reader.last.End
+
+	return query, nil
+}
+
+// parseTableNames parses names separated by commas such as users, public.blog.
+func (p *Parser) parseTableNames(tokens TokenReader) (TableList, error) {
+	res := make(TableList, 0, 1)
+	for {
+		name, err := p.parseTableName(tokens)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, name)
+
+		if peekToken(tokens).Type != COMMA {
+			return res, nil
+		}
+		tokens.Discard(1)
+	}
+}
+
+// parseDropBehavior parses an optional CASCADE or RESTRICT.
+func (p *Parser) parseDropBehavior(tokens TokenReader) (cascade, restrict bool) {
+	t := peekToken(tokens)
+	switch {
+	case isKeyword(t, "cascade"):
+		cascade = true
+	case isKeyword(t, "restrict"):
+		restrict = true
+	default:
+		return false, false
+	}
+	tokens.Discard(1)
+
+	return cascade, restrict
+}
@@ -0,0 +1,39 @@
+package parser
+
+var TestDropQuery = []TestQuery{
+	{ // # 0
+		Query: "drop table users",
+		Ast:   &Drop{Type: DropObjectTable, Names: TableList{{Name: "users"}}},
+	},
+	{ // # 1
+		Query: "DROP TABLE IF EXISTS users, public.blog CASCADE",
+		Ast:   &Drop{Type: DropObjectTable, IfExists: true, Names: TableList{{Name: "users"}, {Name: "public.blog"}}, Cascade: true},
+	},
+	{ // # 2
+		Query: "drop index idx_name on users",
+		Ast:   &Drop{Type: DropObjectIndex, Names: TableList{{Name: "idx_name"}}, Table: TableReference{Name: "users"}},
+	},
+	{ // # 3
+		Query: "drop view if exists active_users restrict",
+		Ast:   &Drop{Type: DropObjectView, IfExists: true, Names: TableList{{Name: "active_users"}}, Restrict: true},
+	},
+	{ // # 4
+		Query: "drop database app",
+		Ast:   &Drop{Type: DropObjectDatabase, Names: TableList{{Name: "app"}}},
+	},
+	{ // # 5
+		Query: "drop sequence users_id_seq, blog_id_seq",
+		Ast:   &Drop{Type: DropObjectSequence, Names: TableList{{Name: "users_id_seq"}, {Name: "blog_id_seq"}}},
+	},
+}
+
+var TestTruncateQuery = []TestQuery{
+	{ // # 0
+		Query: "truncate table users",
+		Ast:   &Truncate{Tables: TableList{{Name: "users"}}},
+	},
+	{ // # 1
+		Query: "TRUNCATE users, blog RESTART IDENTITY CASCADE",
+		Ast:   &Truncate{Tables: TableList{{Name: "users"}, {Name: "blog"}}, RestartIdentity: true, Cascade: true},
+	},
+}
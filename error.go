@@ -0,0 +1,116 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError describes where and why the parser failed.
+// errors.Is(err, ErrInvalidQuery) reports true for a ParseError.
+type ParseError struct {
+	// Token is the offending token.
+	Token    Token
+	Position Position
+	// Expected is the token types which are acceptable instead of Token. It may be empty.
+	Expected []TokenType
+	Message  string
+	// Excerpt is the source line of Token underlined with carets. It is empty unless Parser.Source is set.
+	Excerpt string
+}
+
+func (e *ParseError) Error() string {
+	s := fmt.Sprintf("%v: line %d, column %d: %s", ErrInvalidQuery, e.Position.Line, e.Position.Column, e.Message)
+	if e.Excerpt != "" {
+		s += "\n" + e.Excerpt
+	}
+
+	return s
+}
+
+func (e *ParseError) Is(target error) bool {
+	return target == ErrInvalidQuery
+}
+
+// statementTokenTypes is the token types which can start a statement.
+var statementTokenTypes = []TokenType{SELECT, INSERT, UPDATE, DELETE, CREATE, ALTER, DROP}
+
+// valueTokenTypes is the token types which can start a value expression.
+var valueTokenTypes = []TokenType{IDENT, QUOTED_IDENT, STRING, INT, DECIMAL, FLOAT, QUESTION, PARAM, MINUS, PLUS}
+
+// unexpected returns an error reporting that t is not one of expected.
+func (p *Parser) unexpected(t Token, expected ...TokenType) error {
+	// The reason of the lexer is the message for ILLEGAL.
+	if t.Type == ILLEGAL {
+		return p.newError(t, t.Value, expected)
+	}
+
+	msg := "unexpected " + describeToken(t)
+	switch len(expected) {
+	case 0:
+	case 1:
+		msg += ", expected " + expected[0].String()
+	default:
+		s := make([]string, len(expected))
+		for i, e := range expected {
+			s[i] = e.String()
+		}
+		msg += ", expected one of " + strings.Join(s, ", ")
+	}
+
+	return p.newError(t, msg, expected)
+}
+
+func (p *Parser) newError(t Token, msg string, expected []TokenType) error {
+	return &ParseError{
+		Token:    t,
+		Position: t.Position,
+		Expected: expected,
+		Message:  msg,
+		Excerpt:  excerpt(p.Source, t.Position, t.End),
+	}
+}
+
+func describeToken(t Token) string {
+	switch {
+	case t.Type == EOF:
+		return "end of input"
+	case t.Text != "":
+		return fmt.Sprintf("%v %q", t.Type, t.Text)
+	case t.Value != "":
+		return fmt.Sprintf("%v %q", t.Type, t.Value)
+	}
+
+	return t.Type.String()
+}
+
+// excerpt returns the line of source at start and carets under the range from start to end.
+func excerpt(source string, start, end Position) string {
+	if source == "" || start.Line < 1 {
+		return ""
+	}
+
+	lines := strings.Split(strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(source), "\n")
+	if start.Line > len(lines) {
+		return ""
+	}
+	line := lines[start.Line-1]
+
+	// Keep tabs in the indent so that the carets line up with the source.
+	indent := make([]rune, 0, start.Column)
+	for i, r := range []rune(line) {
+		if i >= start.Column-1 {
+			break
+		}
+		if r == '\t' {
+			indent = append(indent, r)
+		} else {
+			indent = append(indent, ' ')
+		}
+	}
+	width := 1
+	if end.Line == start.Line && end.Column > start.Column {
+		width = end.Column - start.Column
+	}
+
+	return line + "\n" + string(indent) + strings.Repeat("^", width)
+}
@@ -0,0 +1,197 @@
+package parser
+
+// Insert
+// Query: INSERT INTO users (id, name) VALUES (1, 'foo'), (2, 'bar')
+//		  INSERT INTO users SELECT * FROM tmp
+//		  INSERT users (id, name) VALUES (1, 'foo' || 'bar')
+//		  INSERT INTO users (id, name) VALUES (1, 'foo') ON DUPLICATE KEY UPDATE name = VALUES(name)
+//		  INSERT INTO users (id, name) VALUES (1, 'foo') ON CONFLICT (id) DO UPDATE SET name = excluded.name
+// <insert statement> ::= INSERT [ INTO ] <table name> [ <left paren> <column name list> <right paren> ] <insert source> [ <upsert clause> ]
+// <insert source> ::= VALUES <row value constructor> [ { <comma> <row value constructor> }... ] | <query specification>
+// <upsert clause> ::= ON DUPLICATE KEY UPDATE <assignment list> | <on conflict clause>
+// <on conflict clause> ::= ON CONFLICT [ <conflict target> ] DO { NOTHING | UPDATE SET <assignment list> [ <where clause> ] }
+// <conflict target> ::= <left paren> <column name list> <right paren> | ON CONSTRAINT <constraint name>
+
+type Insert struct {
+	Span
+	Table   TableReference
+	Columns []string
+	// Values is the rows of VALUES. It is empty when the rows are given by Select.
+	Values               [][]Expr
+	Select               *Select
+	OnDuplicateKeyUpdate []*Assignment
+	OnConflict           *OnConflict
+}
+
+// OnConflict is the ON CONFLICT clause of PostgreSQL.
+type OnConflict struct {
+	Span
+	// Columns or Constraint is the conflict target. Both are empty when the target is omitted.
+	Columns    []string
+	Constraint string
+	DoNothing  bool
+	Update     []*Assignment
+	Where      Expr
+}
+
+func (*Insert) statementNode() {}
+
+func (p *Parser) parseInsert(tokens TokenReader) (Statement, error) {
+	start, err := p.expect(tokens, INSERT)
+	if err != nil {
+		return nil, err
+	}
+	// INTO is optional in MySQL.
+	if peekToken(tokens).Type == INTO {
+		tokens.Discard(1)
+	}
+	query := &Insert{Span: Span{StartPos: start.Position}}
+
+	table, err := p.parseTableName(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.Table = table
+
+	if t, err := tokens.Peek(1); err == nil && t[0].Type == LPAREN {
+		columns, err := p.parseIdentifierList(tokens)
+		if err != nil {
+			return nil, err
+		}
+		query.Columns = columns
+	}
+
+	t := peekToken(tokens)
+	switch {
+	case isKeyword(t, "values"):
+		tokens.Discard(1)
+		rows, err := p.parseValues(tokens)
+		if err != nil {
+			return nil, err
+		}
+		query.Values = rows
+	case t.Type == SELECT:
+		s, err := p.parseSelect(tokens)
+		if err != nil {
+			return nil, err
+		}
+		query.Select = s.(*Select)
+	default:
+		return nil, p.newError(t, "unexpected "+describeToken(t)+", expected VALUES or SELECT", []TokenType{IDENT, SELECT})
+	}
+
+	if t, err := tokens.Peek(1); err == nil {
+		switch t[0].Type {
+		case ONDUPLICATEKEYUPDATE:
+			tokens.Discard(1)
+			assignments, err := p.parseAssignments(tokens, func(Token) bool { return false })
+			if err != nil {
+				return nil, err
+			}
+			query.OnDuplicateKeyUpdate = assignments
+		case ON:
+			onConflict, err := p.parseOnConflict(tokens)
+			if err != nil {
+				return nil, err
+			}
+			query.OnConflict = onConflict
+		}
+	}
+
+	if err := p.expectEnd(tokens); err != nil {
+		return nil, err
+	}
+	query.EndPos = p.reader.last.End
+
+	return query, nil
+}
+
+// parseValues parses the rows of VALUES.
+func (p *Parser) parseValues(tokens TokenReader) ([][]Expr, error) {
+	res := make([][]Expr, 0)
+	for {
+		list, err := p.parseParenList(tokens)
+		if err != nil {
+			return nil, err
+		}
+		row := make([]Expr, 0, len(list))
+		for _, e := range list {
+			v, err := p.parseExpr(e)
+			if err != nil {
+				return nil, err
+			}
+			row = append(row, v)
+		}
+		res = append(res, row)
+
+		if t, err := tokens.Peek(1); err != nil || t[0].Type != COMMA {
+			return res, nil
+		}
+		tokens.Discard(1)
+	}
+}
+
+func (p *Parser) parseOnConflict(tokens TokenReader) (*OnConflict, error) {
+	on, err := p.expect(tokens, ON)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expectKeyword(tokens, "conflict"); err != nil {
+		return nil, err
+	}
+	res := &OnConflict{Span: Span{StartPos: on.Position}}
+
+	if t, err := tokens.Peek(1); err == nil {
+		switch t[0].Type {
+		case LPAREN:
+			columns, err := p.parseIdentifierList(tokens)
+			if err != nil {
+				return nil, err
+			}
+			res.Columns = columns
+		case ON:
+			tokens.Discard(1)
+			if _, err := p.expectKeyword(tokens, "constraint"); err != nil {
+				return nil, err
+			}
+			name, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+			if err != nil {
+				return nil, err
+			}
+			res.Constraint = name.Value
+		}
+	}
+
+	if _, err := p.expectKeyword(tokens, "do"); err != nil {
+		return nil, err
+	}
+	t := peekToken(tokens)
+	switch {
+	case isKeyword(t, "nothing"):
+		tokens.Discard(1)
+		res.DoNothing = true
+	case t.Type == UPDATE:
+		tokens.Discard(1)
+		if _, err := p.expect(tokens, SET); err != nil {
+			return nil, err
+		}
+		assignments, err := p.parseAssignments(tokens, func(t Token) bool { return t.Type == WHERE })
+		if err != nil {
+			return nil, err
+		}
+		res.Update = assignments
+
+		if t, err := tokens.Peek(1); err == nil && t[0].Type == WHERE {
+			where, err := p.parseWhereClause(tokens)
+			if err != nil {
+				return nil, err
+			}
+			res.Where = where.Cond
+		}
+	default:
+		return nil, p.newError(t, "unexpected "+describeToken(t)+", expected NOTHING or UPDATE", []TokenType{IDENT, UPDATE})
+	}
+	res.EndPos = p.reader.last.End
+
+	return res, nil
+}
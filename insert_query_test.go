@@ -0,0 +1,162 @@
+package parser
+
+var TestInsertQuery = []TestQuery{
+	{ // # 0
+		Query: "insert into users values (1, \"test\")",
+		Ast: &Insert{
+			Table: TableReference{Name: "users"},
+			Values: [][]Expr{
+				{ValueExpr{Type: ValueTypeInt, IntValue: 1}, ValueExpr{Type: ValueTypeString, StringValue: "test"}},
+			},
+		},
+	},
+	{ // # 1
+		Query: "insert into users (id, name) values (1, 'test'), (2, null)",
+		Ast: &Insert{
+			Table:   TableReference{Name: "users"},
+			Columns: []string{"id", "name"},
+			Values: [][]Expr{
+				{ValueExpr{Type: ValueTypeInt, IntValue: 1}, ValueExpr{Type: ValueTypeString, StringValue: "test"}},
+				{ValueExpr{Type: ValueTypeInt, IntValue: 2}, ValueExpr{Type: ValueTypeNull}},
+			},
+		},
+	},
+	{ // # 2
+		Query: "insert into users (id, name) values (1, \"test\") on duplicate key update name = values(name), updated_at = now()",
+		Ast: &Insert{
+			Table:   TableReference{Name: "users"},
+			Columns: []string{"id", "name"},
+			Values: [][]Expr{
+				{ValueExpr{Type: ValueTypeInt, IntValue: 1}, ValueExpr{Type: ValueTypeString, StringValue: "test"}},
+			},
+			OnDuplicateKeyUpdate: []*Assignment{
+				{Column: []string{"name"}, Value: &FuncCall{Name: "values", Args: []Expr{ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"name"}}}}},
+				{Column: []string{"updated_at"}, Value: &FuncCall{Name: "now", Args: []Expr{}}},
+			},
+		},
+	},
+	{ // # 3
+		Query: "insert into users select * from tmp where id > 10",
+		Ast: &Insert{
+			Table: TableReference{Name: "users"},
+			Select: &Select{
+				SelectList: []SelectExpr{{Asterisk: true}},
+				Table: TableExpression{
+					From: FromClause{Table: []TableReference{{Name: "tmp"}}},
+					Where: WhereClause{Cond: &ComparisonExpr{
+						Operator:   ComparisonOperatorGreaterThan,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"id"}},
+						RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 10},
+					}},
+				},
+			},
+		},
+	},
+	{ // # 4
+		Query: "INSERT INTO public.users (id, name) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET name = excluded.name WHERE users.id > 0",
+		Ast: &Insert{
+			Table:   TableReference{Name: "public.users"},
+			Columns: []string{"id", "name"},
+			Values: [][]Expr{
+				{ValueExpr{Type: ValueTypeDynamicParameter, ParameterIndex: 1}, ValueExpr{Type: ValueTypeDynamicParameter, ParameterIndex: 2}},
+			},
+			OnConflict: &OnConflict{
+				Columns: []string{"id"},
+				Update: []*Assignment{
+					{Column: []string{"name"}, Value: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"excluded", "name"}}},
+				},
+				Where: &ComparisonExpr{
+					Operator:   ComparisonOperatorGreaterThan,
+					LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"users", "id"}},
+					RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 0},
+				},
+			},
+		},
+	},
+	{ // # 5
+		Query: "insert into users (id) values (default) on conflict on constraint users_pkey do nothing",
+		Ast: &Insert{
+			Table:   TableReference{Name: "users"},
+			Columns: []string{"id"},
+			Values: [][]Expr{
+				{ValueExpr{Type: ValueTypeDefault}},
+			},
+			OnConflict: &OnConflict{Constraint: "users_pkey", DoNothing: true},
+		},
+	},
+	{ // # 6
+		Query: "insert into counters (id, cnt, total) values (1, 1, 10) on duplicate key update cnt = cnt + 1, total = total + values(total)",
+		Ast: &Insert{
+			Table:   TableReference{Name: "counters"},
+			Columns: []string{"id", "cnt", "total"},
+			Values: [][]Expr{
+				{ValueExpr{Type: ValueTypeInt, IntValue: 1}, ValueExpr{Type: ValueTypeInt, IntValue: 1}, ValueExpr{Type: ValueTypeInt, IntValue: 10}},
+			},
+			OnDuplicateKeyUpdate: []*Assignment{
+				{Column: []string{"cnt"}, Value: &ArithmeticExpr{
+					Operator: Token{Type: PLUS},
+					Left:     ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"cnt"}},
+					Right:    ValueExpr{Type: ValueTypeInt, IntValue: 1},
+				}},
+				{Column: []string{"total"}, Value: &ArithmeticExpr{
+					Operator: Token{Type: PLUS},
+					Left:     ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"total"}},
+					Right:    &FuncCall{Name: "values", Args: []Expr{ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"total"}}}},
+				}},
+			},
+		},
+	},
+	{ // # 7
+		Query: "insert into archive select * from users order by id limit 100",
+		Ast: &Insert{
+			Table: TableReference{Name: "archive"},
+			Select: &Select{
+				SelectList: []SelectExpr{{Asterisk: true}},
+				Table:      TableExpression{From: FromClause{Table: []TableReference{{Name: "users"}}}},
+				OrderBy:    OrderByClause{{Key: Token{Type: IDENT, Value: "id"}}},
+				Limit:      ValueExpr{Type: ValueTypeInt, IntValue: 100},
+			},
+		},
+	},
+	{ // # 8
+		Query: "INSERT users (id, name) VALUES (1, 'a' || name || 1 + 2)",
+		Ast: &Insert{
+			Table:   TableReference{Name: "users"},
+			Columns: []string{"id", "name"},
+			Values: [][]Expr{
+				{ValueExpr{Type: ValueTypeInt, IntValue: 1}, &ArithmeticExpr{
+					Operator: Token{Type: CONCAT},
+					Left: &ArithmeticExpr{
+						Operator: Token{Type: CONCAT},
+						Left:     ValueExpr{Type: ValueTypeString, StringValue: "a"},
+						Right:    ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"name"}},
+					},
+					Right: &ArithmeticExpr{
+						Operator: Token{Type: PLUS},
+						Left:     ValueExpr{Type: ValueTypeInt, IntValue: 1},
+						Right:    ValueExpr{Type: ValueTypeInt, IntValue: 2},
+					},
+				}},
+			},
+		},
+	},
+}
+
+var TestInsertErrorQuery = []TestErrorQuery{
+	{ // # 0
+		Query:    "INSERT INTO t VALUES (1) ON DUPLICATE KEY UPDATE = 1",
+		Position: Position{Line: 1, Offset: 49, Column: 50},
+	},
+	{ // # 1
+		Query:    "INSERT INTO t VALUES (1) ON CONFLICT DO UPDATE SET = 1",
+		Position: Position{Line: 1, Offset: 51, Column: 52},
+	},
+	{ // # 2
+		Query:    "INSERT INTO t VALUES (1) ON DUPLICATE KEY UPDATE a = 1, , b = 2",
+		Position: Position{Line: 1, Offset: 53, Column: 54},
+	},
+	{ // # 3
+		Query:    "INSERT t VALUES (1 ||)",
+		Position: Position{Line: 1, Offset: 19, Column: 20},
+	},
+}
@@ -2,26 +2,59 @@ package parser
 
 import (
 	"bufio"
+	"fmt"
 	"io"
+	"math/big"
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 type Lexer struct {
-	ctx *lexerCtx
+	ctx     *lexerCtx
+	dialect Dialect
+	trivia  bool
+	folding IdentifierFolding
+
+	// delimiter is the statement delimiter which is changed by the DELIMITER command of MySQL.
+	delimiter string
+	// statementStart is true when the next token begins a statement.
+	statementStart bool
+	// pending is an ILLEGAL token found while reading the trailing trivia. It is returned by the next Scan.
+	pending *Token
+}
+
+type LexerOption func(*Lexer)
+
+// WithDialect sets the dialect used for quoting and escaping rules. The default is DialectMySQL.
+func WithDialect(d Dialect) LexerOption {
+	return func(l *Lexer) {
+		l.dialect = d
+	}
 }
 
 type lexerCtx struct {
-	r       *bufio.Reader
-	line    int
-	start   int
-	cur     int
-	prevCur int
+	r    *bufio.Reader
+	line int
+	// runes and lineStart count runes, cur counts bytes.
+	runes     int
+	lineStart int
+	cur       int
+	start     Position
+	// text is the source text since mark.
+	text []rune
 }
 
+// Position returns the position of the next rune.
 func (ctx *lexerCtx) Position() Position {
-	return Position{Line: ctx.line, Offset: ctx.prevCur, Column: ctx.cur - ctx.start}
+	return Position{Line: ctx.line, Offset: ctx.cur, Column: ctx.runes - ctx.lineStart + 1}
+}
+
+// mark records the current position as the beginning of the next token.
+func (ctx *lexerCtx) mark() {
+	ctx.start = ctx.Position()
+	ctx.text = ctx.text[:0]
 }
 
 func (ctx *lexerCtx) Token(typ TokenType, value interface{}) Token {
@@ -34,49 +67,94 @@ func (ctx *lexerCtx) Token(typ TokenType, value interface{}) Token {
 	case string:
 		stringValue = x
 	}
-	return Token{Type: typ, Position: ctx.Position(), Value: stringValue, IntValue: intValue}
+	return Token{Type: typ, Position: ctx.start, End: ctx.Position(), Text: string(ctx.text), Value: stringValue, IntValue: intValue}
 }
 
+// peek decodes the next rune without consuming it. An invalid UTF-8 sequence is returned as utf8.RuneError.
 func (ctx *lexerCtx) peek() (rune, error) {
-	b, err := ctx.r.Peek(1)
-	if err != nil {
+	b, err := ctx.r.Peek(utf8.UTFMax)
+	if len(b) == 0 {
 		return 0, err
 	}
-	if isLineBreak(rune(b[0])) {
-		ctx.line++
+	r, _ := utf8.DecodeRune(b)
+	return r, nil
+}
+
+// peekAt decodes the rune at off bytes ahead without consuming the input. size is 0 when there is no rune.
+func (ctx *lexerCtx) peekAt(off int) (r rune, size int) {
+	b, _ := ctx.r.Peek(off + utf8.UTFMax)
+	if len(b) <= off {
+		return 0, 0
 	}
-	return rune(b[0]), err
+	return utf8.DecodeRune(b[off:])
 }
 
+// discard consumes the next rune.
 func (ctx *lexerCtx) discard() {
-	ctx.cur++
-	ctx.r.Discard(1)
+	b, _ := ctx.r.Peek(utf8.UTFMax)
+	if len(b) == 0 {
+		return
+	}
+	r, size := utf8.DecodeRune(b)
+	ctx.cur += size
+	ctx.runes++
+	ctx.r.Discard(size)
+	ctx.text = append(ctx.text, r)
+	if isLineBreak(r) {
+		// CRLF is a single line break. The line is counted when LF is consumed.
+		if next, err := ctx.r.Peek(1); r == '\r' && err == nil && next[0] == '\n' {
+			return
+		}
+		ctx.line++
+		ctx.lineStart = ctx.runes
+	}
 }
 
-func (ctx *lexerCtx) skipWhiteSpace() {
-	for {
-		r, err := ctx.peek()
-		if err == io.EOF {
-			break
-		}
-		if isWhiteSpace(r) {
-			ctx.discard()
-		} else {
-			break
-		}
+func (ctx *lexerCtx) discardN(n int) {
+	for i := 0; i < n; i++ {
+		ctx.discard()
 	}
 }
 
-func NewLexer(r io.Reader) *Lexer {
-	return &Lexer{ctx: &lexerCtx{r: bufio.NewReader(r), line: 1, cur: 0}}
+// WithTrivia makes the Lexer keep comments as leading and trailing trivia of tokens instead of dropping them.
+// A comment which follows a token on the same line is a trailing trivia of that token,
+// others are the leading trivia of the next token.
+func WithTrivia() LexerOption {
+	return func(l *Lexer) {
+		l.trivia = true
+	}
+}
+
+// WithIdentifierFolding sets the case conversion of unquoted identifiers. The default is FoldLower.
+// Keywords are case insensitive and quoted identifiers keep their case regardless of this option.
+func WithIdentifierFolding(f IdentifierFolding) LexerOption {
+	return func(l *Lexer) {
+		l.folding = f
+	}
+}
+
+func NewLexer(r io.Reader, opts ...LexerOption) *Lexer {
+	l := &Lexer{ctx: &lexerCtx{r: bufio.NewReader(r), line: 1, cur: 0}, delimiter: ";", statementStart: true}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
 }
 
 func (lexer *Lexer) Scan() (Token, error) {
+	if lexer.pending != nil {
+		token := *lexer.pending
+		lexer.pending = nil
+		return token, nil
+	}
+
+	var leading []Token
 	var r rune
 	for {
 		b, err := lexer.ctx.peek()
 		if err == io.EOF {
-			return Token{Type: EOF}, err
+			pos := lexer.ctx.Position()
+			return Token{Type: EOF, Position: pos, End: pos, LeadingTrivia: leading}, err
 		}
 		if err != nil {
 			return Token{}, err
@@ -89,64 +167,361 @@ func (lexer *Lexer) Scan() (Token, error) {
 		} else if isLineBreak(r) {
 			lexer.ctx.discard()
 			continue
+		} else if lexer.isCommentStart(lexer.ctx, 0) {
+			lexer.ctx.mark()
+			typ, value := lexer.scanComment(lexer.ctx)
+			if typ == ILLEGAL {
+				return lexer.ctx.Token(typ, value), nil
+			}
+			if lexer.trivia {
+				leading = append(leading, lexer.ctx.Token(typ, value))
+			}
+			continue
 		} else {
-			lexer.ctx.prevCur = lexer.ctx.cur
-			lexer.ctx.start = lexer.ctx.cur
+			lexer.ctx.mark()
 			break
 		}
 	}
 
-	r = unicode.ToLower(r)
+	typ, value := lexer.scanToken(lexer.ctx, r)
+	token := lexer.ctx.Token(typ, value)
+	switch token.Type {
+	case INT, DECIMAL, FLOAT:
+		setNumberValue(&token)
+	}
+	if lexer.trivia {
+		token.LeadingTrivia = leading
+		token.TrailingTrivia = lexer.scanTrailingTrivia(lexer.ctx)
+	}
+	lexer.statementStart = token.Type == DELIMITER || (token.Type == SEMICOLON && token.Value == lexer.delimiter)
+	return token, nil
+}
+
+func (lexer *Lexer) scanToken(ctx *lexerCtx, r rune) (TokenType, interface{}) {
+	if lexer.isDelimiter(ctx) {
+		ctx.discardN(utf8.RuneCountInString(lexer.delimiter))
+		return SEMICOLON, lexer.delimiter
+	}
+
 	var typ TokenType
 	var value interface{}
 	switch r {
+	case ';':
+		ctx.discard()
+		typ, value = SEMICOLON, ";"
 	case ',':
-		lexer.ctx.discard()
+		ctx.discard()
 		typ = COMMA
 	case '.':
-		lexer.ctx.discard()
+		if b, _ := ctx.r.Peek(2); len(b) == 2 && isDigit(rune(b[1])) {
+			typ, value = lexer.scanNumber(ctx)
+			break
+		}
+		ctx.discard()
 		typ = PERIOD
 	case '*':
-		lexer.ctx.discard()
+		ctx.discard()
 		typ = ASTERISK
-	case '=':
-		lexer.ctx.discard()
-		typ = EQUAL
-	case '<':
-		lexer.ctx.discard()
-		typ = LSS
-	case '>':
-		lexer.ctx.discard()
-		typ = GTR
+	case ':', '@', '$':
+		if t, v, ok := lexer.scanParam(ctx); ok {
+			typ, value = t, v
+			break
+		}
+		if t, ok := lexer.scanOperator(ctx); ok {
+			typ = t
+		} else {
+			typ, value = lexer.scanStatement(ctx, r)
+		}
+	case '=', '<', '>', '!', '+', '-', '/', '%', '|', '&', '^', '~':
+		if t, ok := lexer.scanOperator(ctx); ok {
+			typ = t
+		} else {
+			typ, value = lexer.scanStatement(ctx, r)
+		}
 	case '(':
-		lexer.ctx.discard()
+		ctx.discard()
 		typ = LPAREN
 	case ')':
-		lexer.ctx.discard()
+		ctx.discard()
 		typ = RPAREN
 	case '?':
-		lexer.ctx.discard()
+		ctx.discard()
 		typ = QUESTION
+	case '\'':
+		typ, value = lexer.scanString(ctx, r)
+	case '`':
+		typ, value = lexer.scanQuotedIdent(ctx, '`')
+	case '"':
+		// MySQL treats double quoted text as a string literal unless ANSI_QUOTES is enabled.
+		if lexer.dialect == DialectMySQL {
+			typ, value = lexer.scanString(ctx, r)
+		} else {
+			typ, value = lexer.scanQuotedIdent(ctx, '"')
+		}
+	case '[':
+		if lexer.dialect == DialectSQLServer {
+			typ, value = lexer.scanQuotedIdent(ctx, ']')
+		} else {
+			typ, value = lexer.scanStatement(ctx, r)
+		}
 	default:
-		typ, value = lexer.scanStatement(lexer.ctx, r)
+		if isDigit(r) {
+			typ, value = lexer.scanNumber(ctx)
+		} else {
+			typ, value = lexer.scanStatement(ctx, r)
+		}
 	}
 
-	token := lexer.ctx.Token(typ, value)
-	return token, nil
+	return typ, value
+}
+
+// isDelimiter reports whether the input continues with the delimiter set by the DELIMITER command.
+func (lexer *Lexer) isDelimiter(ctx *lexerCtx) bool {
+	if lexer.delimiter == ";" {
+		return false
+	}
+	b, _ := ctx.r.Peek(len(lexer.delimiter))
+	return string(b) == lexer.delimiter
+}
+
+// scanDelimiter reads the argument of the DELIMITER command which is the rest of the word on the same line.
+func (lexer *Lexer) scanDelimiter(ctx *lexerCtx) (TokenType, interface{}) {
+	for {
+		r, err := ctx.peek()
+		if err != nil || !isWhiteSpace(r) {
+			break
+		}
+		ctx.discard()
+	}
+
+	delimiter := make([]rune, 0)
+	for {
+		r, err := ctx.peek()
+		if err != nil || isWhiteSpace(r) || isLineBreak(r) {
+			break
+		}
+		ctx.discard()
+		delimiter = append(delimiter, r)
+	}
+	if len(delimiter) == 0 {
+		return ILLEGAL, "DELIMITER requires a delimiter"
+	}
+
+	lexer.delimiter = string(delimiter)
+	return DELIMITER, lexer.delimiter
+}
+
+// scanTrailingTrivia reads comments which follow the current token on the same line.
+func (lexer *Lexer) scanTrailingTrivia(ctx *lexerCtx) []Token {
+	var trivia []Token
+	for {
+		r, err := ctx.peek()
+		if err != nil {
+			return trivia
+		}
+		if isWhiteSpace(r) {
+			ctx.discard()
+			continue
+		}
+		if !lexer.isCommentStart(ctx, 0) {
+			return trivia
+		}
+
+		ctx.mark()
+		typ, value := lexer.scanComment(ctx)
+		if typ == ILLEGAL {
+			t := ctx.Token(typ, value)
+			lexer.pending = &t
+			return trivia
+		}
+		trivia = append(trivia, ctx.Token(typ, value))
+	}
+}
+
+// isCommentStart reports whether a comment starts at off bytes ahead.
+func (lexer *Lexer) isCommentStart(ctx *lexerCtx, off int) bool {
+	b, _ := ctx.r.Peek(off + 3)
+	if len(b) <= off {
+		return false
+	}
+	b = b[off:]
+	if b[0] == '#' && lexer.dialect == DialectMySQL {
+		return true
+	}
+	if len(b) < 2 {
+		return false
+	}
+
+	switch string(b[:2]) {
+	case "/*":
+		return true
+	case "--":
+		if lexer.dialect != DialectMySQL {
+			return true
+		}
+		// MySQL requires whitespace or a control character after "--",
+		// so that 5--3 is read as 5 - -3.
+		return len(b) < 3 || b[2] <= ' ' || b[2] == 0x7f
+	}
+	return false
+}
+
+// commentAt returns the length in bytes and in runes of the comment which starts at off bytes ahead.
+// Both are 0 when there is no comment or a block comment is not terminated. The input is not consumed.
+func (lexer *Lexer) commentAt(ctx *lexerCtx, off int) (size, runes int) {
+	if !lexer.isCommentStart(ctx, off) {
+		return 0, 0
+	}
+	first, _ := ctx.peekAt(off)
+	second, _ := ctx.peekAt(off + 1)
+	block := first == '/' && second == '*'
+
+	depth := 0
+	for {
+		r, n := ctx.peekAt(off + size)
+		if n == 0 {
+			if block {
+				return 0, 0
+			}
+			return size, runes
+		}
+		if block {
+			next, _ := ctx.peekAt(off + size + n)
+			switch {
+			case r == '/' && next == '*' && (depth == 0 || lexer.dialect == DialectPostgreSQL):
+				depth++
+			case r == '*' && next == '/':
+				depth--
+			default:
+				size += n
+				runes++
+				continue
+			}
+			size += 2
+			runes += 2
+			if depth == 0 {
+				return size, runes
+			}
+			continue
+		}
+
+		size += n
+		runes++
+		if isLineBreak(r) {
+			return size, runes
+		}
+	}
+}
+
+// lookahead reports whether the input continues with words which are preceded by white spaces or comments.
+// Words are matched case insensitively and have to end at a terminator. n is the number of runes of the match.
+// The input is not consumed.
+func (lexer *Lexer) lookahead(ctx *lexerCtx, words ...string) (n int, ok bool) {
+	off := 0
+	for _, w := range words {
+		spaces := 0
+		for {
+			if r, size := ctx.peekAt(off); size != 0 && (isWhiteSpace(r) || isLineBreak(r)) {
+				off += size
+				spaces++
+				continue
+			}
+			size, runes := lexer.commentAt(ctx, off)
+			if runes == 0 {
+				break
+			}
+			off += size
+			spaces += runes
+		}
+		if spaces == 0 {
+			return 0, false
+		}
+		n += spaces
+
+		for _, c := range w {
+			r, size := ctx.peekAt(off)
+			if size == 0 || unicode.ToLower(r) != c {
+				return 0, false
+			}
+			off += size
+			n++
+		}
+	}
+	if r, size := ctx.peekAt(off); size != 0 && !isTerminator(r) {
+		return 0, false
+	}
+
+	return n, true
+}
+
+func (lexer *Lexer) scanComment(ctx *lexerCtx) (TokenType, interface{}) {
+	if b, _ := ctx.r.Peek(2); string(b) == "/*" {
+		return lexer.scanBlockComment(ctx)
+	}
+	return lexer.scanLineComment(ctx)
+}
+
+// scanLineComment reads a comment until the end of line. The line break is consumed but not included in the value.
+func (lexer *Lexer) scanLineComment(ctx *lexerCtx) (TokenType, interface{}) {
+	comment := make([]rune, 0)
+	for {
+		r, err := ctx.peek()
+		if err != nil {
+			break
+		}
+		ctx.discard()
+		if isLineBreak(r) {
+			break
+		}
+		comment = append(comment, r)
+	}
+
+	return COMMENT, string(comment)
+}
+
+// scanBlockComment reads a comment enclosed by /* and */. PostgreSQL allows block comments to be nested.
+func (lexer *Lexer) scanBlockComment(ctx *lexerCtx) (TokenType, interface{}) {
+	comment := make([]rune, 0)
+	depth := 0
+	for {
+		b, _ := ctx.r.Peek(2)
+		switch {
+		case string(b) == "/*" && (depth == 0 || lexer.dialect == DialectPostgreSQL):
+			depth++
+		case string(b) == "*/":
+			depth--
+		default:
+			r, err := ctx.peek()
+			if err != nil {
+				return ILLEGAL, "unterminated block comment"
+			}
+			ctx.discard()
+			comment = append(comment, r)
+			continue
+		}
+
+		comment = append(comment, rune(b[0]), rune(b[1]))
+		ctx.discard()
+		ctx.discard()
+		if depth == 0 {
+			return COMMENT, string(comment)
+		}
+	}
 }
 
 func (lexer *Lexer) scanStatement(ctx *lexerCtx, s rune) (TokenType, interface{}) {
 	statement := make([]rune, 0, 0)
 	statement = append(statement, s)
 	ctx.discard()
+	if !isWordStart(s) {
+		return ILLEGAL, fmt.Sprintf("unknown character %q", s)
+	}
 	for {
 		r, err := ctx.peek()
 		if err == io.EOF {
 			break
 		}
-		if isWhiteSpace(r) {
-			break
-		} else if isComma(r) || isPeriod(r) || isParen(r) {
+		if isTerminator(r) || lexer.isDelimiter(ctx) {
 			break
 		} else {
 			statement = append(statement, r)
@@ -163,10 +538,14 @@ func (lexer *Lexer) scanStatement(ctx *lexerCtx, s rune) (TokenType, interface{}
 		return INSERT, nil
 	case "update":
 		return UPDATE, nil
+	case "delete":
+		return DELETE, nil
 	case "create":
 		return CREATE, nil
 	case "alter":
 		return ALTER, nil
+	case "drop":
+		return DROP, nil
 	case "from":
 		return FROM, nil
 	case "as":
@@ -178,46 +557,28 @@ func (lexer *Lexer) scanStatement(ctx *lexerCtx, s rune) (TokenType, interface{}
 	case "into":
 		return INTO, nil
 	case "order", "group":
-		ctx.skipWhiteSpace()
-		b, err := ctx.r.Peek(2)
-		if err == io.EOF {
-			ctx.cur += 2
-			ctx.r.Discard(2)
-			return ILLEGAL, nil
-		}
-		if err != nil {
-			ctx.cur += 2
-			ctx.r.Discard(2)
-			return ILLEGAL, nil
-		}
-		ctx.cur += 2
-		ctx.r.Discard(2)
-		if string(b) == "by" {
+		if n, ok := lexer.lookahead(ctx, "by"); ok {
+			ctx.discardN(n)
 			if state == "order" {
 				return ORDERBY, nil
-			} else {
-				return GROUPBY, nil
 			}
+			return GROUPBY, nil
 		}
+		return ILLEGAL, fmt.Sprintf("%s is not followed by BY", strings.ToUpper(state))
 	case "primary":
-		ctx.skipWhiteSpace()
-		b, err := ctx.r.Peek(3)
-		if err == io.EOF {
-			ctx.cur += 3
-			ctx.r.Discard(3)
-			return ILLEGAL, nil
-		}
-		if string(b) == "key" {
-			ctx.cur += 3
-			ctx.r.Discard(3)
+		if n, ok := lexer.lookahead(ctx, "key"); ok {
+			ctx.discardN(n)
 			return PRIMARYKEY, nil
 		}
+		return ILLEGAL, "PRIMARY is not followed by KEY"
 	case "desc":
 		return DESC, nil
 	case "asc":
 		return ASC, nil
 	case "having":
 		return HAVING, nil
+	case "limit":
+		return LIMIT, nil
 	case "left":
 		return LEFT, nil
 	case "right":
@@ -231,48 +592,17 @@ func (lexer *Lexer) scanStatement(ctx *lexerCtx, s rune) (TokenType, interface{}
 	case "join":
 		return JOIN, nil
 	case "on":
-		b, err := ctx.r.Peek(4)
-		if err == io.EOF {
-			ctx.cur += 4
-			ctx.r.Discard(4)
-			return ILLEGAL, nil
+		if n, ok := lexer.lookahead(ctx, "duplicate", "key", "update"); ok {
+			ctx.discardN(n)
+			return ONDUPLICATEKEYUPDATE, nil
 		}
-		if string(b) == " dup" {
-			ctx.cur += 10
-			ctx.r.Discard(10)
-		} else {
-			return ON, nil
+		return ON, nil
+	case "delimiter":
+		// DELIMITER is a command of the mysql client, so it is recognized only at the beginning of a statement.
+		if lexer.dialect == DialectMySQL && lexer.statementStart {
+			return lexer.scanDelimiter(ctx)
 		}
-		ctx.skipWhiteSpace()
-		b, err = ctx.r.Peek(3)
-		if err == io.EOF {
-			ctx.cur += 3
-			ctx.r.Discard(3)
-			return ILLEGAL, nil
-		}
-		if string(b) != "key" {
-			ctx.cur += 3
-			ctx.r.Discard(3)
-			return ILLEGAL, nil
-		}
-		ctx.cur += 3
-		ctx.r.Discard(3)
-
-		ctx.skipWhiteSpace()
-		b, err = ctx.r.Peek(6)
-		if err == io.EOF {
-			ctx.cur += 6
-			ctx.r.Discard(6)
-			return ILLEGAL, nil
-		}
-		if string(b) != "update" {
-			ctx.cur += 6
-			ctx.r.Discard(6)
-			return ILLEGAL, nil
-		}
-		ctx.cur += 6
-		ctx.r.Discard(6)
-		return ONDUPLICATEKEYUPDATE, nil
+		return IDENT, lexer.fold(string(statement))
 	case "database":
 		return DATABASE, nil
 	case "table":
@@ -287,7 +617,9 @@ func (lexer *Lexer) scanStatement(ctx *lexerCtx, s rune) (TokenType, interface{}
 		return OR, nil
 	case "not":
 		return NOT, nil
-	case "exist":
+	case "if":
+		return IF, nil
+	case "exist", "exists":
 		return EXIST, nil
 	case "add":
 		return ADD, nil
@@ -301,31 +633,325 @@ func (lexer *Lexer) scanStatement(ctx *lexerCtx, s rune) (TokenType, interface{}
 		return DEFAULT, nil
 	case "varchar":
 		return VARCHAR, nil
-	case "int":
+	case "int", "integer":
 		return INTEGER, nil
+	case "index":
+		return INDEX, nil
 	case "unique":
 		return UNIQUE, nil
 	case "null":
 		return NULL, nil
 	default:
-		if i, err := strconv.Atoi(state); err == nil {
-			return INT, i
+		return IDENT, lexer.fold(string(statement))
+	}
+}
+
+// operators is the list of operators ordered by the length. The longest operator has to be matched first.
+var operators = []struct {
+	Text string
+	Type TokenType
+}{
+	{"<=>", NULLSAFEEQUAL},
+	{"->>", LONGARROW},
+	{"<=", LEQ},
+	{">=", GEQ},
+	{"<>", NEQ},
+	{"!=", NEQ},
+	{"<<", SHL},
+	{">>", SHR},
+	{"||", CONCAT},
+	{"::", DOUBLECOLON},
+	{"->", ARROW},
+	{"=", EQUAL},
+	{"<", LSS},
+	{">", GTR},
+	{"+", PLUS},
+	{"-", MINUS},
+	{"/", SLASH},
+	{"%", PERCENT},
+	{"&", AMPERSAND},
+	{"|", PIPE},
+	{"^", CARET},
+	{"~", TILDE},
+}
+
+func (lexer *Lexer) scanOperator(ctx *lexerCtx) (TokenType, bool) {
+	for _, op := range operators {
+		b, _ := ctx.r.Peek(len(op.Text))
+		if string(b) != op.Text {
+			continue
+		}
+
+		ctx.discardN(len(op.Text))
+		return op.Type, true
+	}
+
+	return ILLEGAL, false
+}
+
+func (lexer *Lexer) fold(ident string) string {
+	switch lexer.folding {
+	case FoldLower:
+		return strings.ToLower(ident)
+	case FoldUpper:
+		return strings.ToUpper(ident)
+	}
+	return ident
+}
+
+// scanParam reads a placeholder. $1 is a positional placeholder and the value is the index.
+// :name and @name are named placeholders and the value is the name without the prefix.
+func (lexer *Lexer) scanParam(ctx *lexerCtx) (TokenType, interface{}, bool) {
+	b, _ := ctx.r.Peek(1 + utf8.UTFMax)
+	if len(b) < 2 {
+		return ILLEGAL, nil, false
+	}
+	next, _ := utf8.DecodeRune(b[1:])
+
+	switch b[0] {
+	case '$':
+		if !isDigit(next) {
+			return ILLEGAL, nil, false
+		}
+		ctx.discard()
+		i, err := strconv.Atoi(string(lexer.scanRunes(ctx, isDigit)))
+		if err != nil {
+			return ILLEGAL, "bad parameter index", true
+		}
+		return PARAM, i, true
+	case ':', '@':
+		if !isIdentStart(next) {
+			return ILLEGAL, nil, false
+		}
+		ctx.discard()
+		return PARAM, string(lexer.scanRunes(ctx, isIdentPart)), true
+	}
+
+	return ILLEGAL, nil, false
+}
+
+// scanNumber reads a numeric literal and returns its source text.
+// The literal is INT for integers and hexadecimal numbers, DECIMAL when it has a fractional part and FLOAT when it has an exponent.
+func (lexer *Lexer) scanNumber(ctx *lexerCtx) (TokenType, interface{}) {
+	text := make([]rune, 0)
+	if b, _ := ctx.r.Peek(2); len(b) == 2 && b[0] == '0' && (b[1] == 'x' || b[1] == 'X') {
+		text = append(text, rune(b[0]), rune(b[1]))
+		ctx.discard()
+		ctx.discard()
+		digits := lexer.scanRunes(ctx, isHexDigit)
+		if len(digits) == 0 {
+			return ILLEGAL, "bad number: hexadecimal literal has no digits"
+		}
+		if r, err := ctx.peek(); err == nil && isIdentPart(r) {
+			lexer.scanRunes(ctx, isIdentPart)
+			return ILLEGAL, "bad number: invalid hexadecimal digit"
+		}
+		return INT, string(append(text, digits...))
+	}
+
+	typ := INT
+	text = append(text, lexer.scanRunes(ctx, isDigit)...)
+	if r, err := ctx.peek(); err == nil && r == '.' {
+		typ = DECIMAL
+		ctx.discard()
+		text = append(text, r)
+		text = append(text, lexer.scanRunes(ctx, isDigit)...)
+	}
+
+	// The exponent is read only when it has digits. Otherwise "1e" is a bad number below.
+	b, _ := ctx.r.Peek(3)
+	if len(b) >= 2 && (b[0] == 'e' || b[0] == 'E') {
+		n := 1
+		if b[1] == '+' || b[1] == '-' {
+			n = 2
+		}
+		if len(b) > n && isDigit(rune(b[n])) {
+			typ = FLOAT
+			for i := 0; i < n; i++ {
+				text = append(text, rune(b[i]))
+				ctx.discard()
+			}
+			text = append(text, lexer.scanRunes(ctx, isDigit)...)
+		}
+	}
+	if r, err := ctx.peek(); err == nil && (isIdentPart(r) || r == '.') {
+		lexer.scanRunes(ctx, func(r rune) bool { return isIdentPart(r) || r == '.' })
+		return ILLEGAL, "bad number"
+	}
+
+	return typ, string(text)
+}
+
+func (lexer *Lexer) scanRunes(ctx *lexerCtx, accept func(rune) bool) []rune {
+	digits := make([]rune, 0)
+	for {
+		r, err := ctx.peek()
+		if err != nil || !accept(r) {
+			return digits
 		}
-		return IDENT, state
+		ctx.discard()
+		digits = append(digits, r)
 	}
+}
 
-	return ILLEGAL, nil
+// setNumberValue parses the source text of a numeric literal token.
+func setNumberValue(t *Token) {
+	switch t.Type {
+	case INT:
+		i := new(big.Int)
+		if len(t.Value) > 2 && (t.Value[1] == 'x' || t.Value[1] == 'X') {
+			i.SetString(t.Value[2:], 16)
+		} else {
+			i.SetString(t.Value, 10)
+		}
+		if i.IsInt64() && int64(int(i.Int64())) == i.Int64() {
+			t.IntValue = int(i.Int64())
+		} else {
+			t.BigIntValue = i
+		}
+	case DECIMAL, FLOAT:
+		// ParseFloat reports ErrRange for a literal such as 1e400 but the result is still the nearest value (Inf).
+		t.FloatValue, _ = strconv.ParseFloat(t.Value, 64)
+		t.BigFloatValue, _, _ = big.ParseFloat(t.Value, 10, uint(len(t.Value))*4+64, big.ToNearestEven)
+	}
 }
 
+// scanString reads a literal enclosed by quote. A doubled quote is an escaped quote in every dialect,
+// and MySQL additionally interprets backslash escape sequences.
+func (lexer *Lexer) scanString(ctx *lexerCtx, quote rune) (TokenType, interface{}) {
+	str := make([]rune, 0)
+	ctx.discard()
+	for {
+		r, err := ctx.peek()
+		if err != nil {
+			return ILLEGAL, "unterminated string"
+		}
+		ctx.discard()
+
+		switch {
+		case r == quote:
+			if next, err := ctx.peek(); err == nil && next == quote {
+				ctx.discard()
+				str = append(str, quote)
+				continue
+			}
+			return STRING, string(str)
+		case r == '\\' && lexer.dialect == DialectMySQL:
+			next, err := ctx.peek()
+			if err != nil {
+				return ILLEGAL, "unterminated string"
+			}
+			ctx.discard()
+			str = append(str, unescapeMySQL(next)...)
+		default:
+			str = append(str, r)
+		}
+	}
+}
+
+// scanQuotedIdent reads an identifier which is closed by end. The quotes are stripped and the case is preserved.
+// The closing quote can be escaped by doubling it.
+func (lexer *Lexer) scanQuotedIdent(ctx *lexerCtx, end rune) (TokenType, interface{}) {
+	ident := make([]rune, 0)
+	ctx.discard()
+	for {
+		r, err := ctx.peek()
+		if err != nil {
+			return ILLEGAL, "unterminated quoted identifier"
+		}
+		ctx.discard()
+
+		if r == end {
+			if next, err := ctx.peek(); err == nil && next == end {
+				ctx.discard()
+				ident = append(ident, end)
+				continue
+			}
+			return QUOTED_IDENT, string(ident)
+		}
+		ident = append(ident, r)
+	}
+}
+
+func unescapeMySQL(r rune) []rune {
+	switch r {
+	case '0':
+		return []rune{0}
+	case 'b':
+		return []rune{'\b'}
+	case 'n':
+		return []rune{'\n'}
+	case 'r':
+		return []rune{'\r'}
+	case 't':
+		return []rune{'\t'}
+	case 'Z':
+		return []rune{'\x1a'}
+	case '%', '_':
+		// \% and \_ keep the backslash so that they still work as escaped wildcards in LIKE.
+		return []rune{'\\', r}
+	}
+	return []rune{r}
+}
+
+// isWhiteSpace reports whether r is a white space except line breaks. Unicode spaces such as U+3000 are included.
 func isWhiteSpace(r rune) bool {
-	if r == ' ' {
+	if unicode.IsSpace(r) && !isLineBreak(r) {
 		return true
 	}
 	return false
 }
 
 func isLineBreak(r rune) bool {
-	if r == '\n' {
+	if r == '\n' || r == '\r' {
+		return true
+	}
+	return false
+}
+
+// isTerminator reports whether r ends a keyword or an identifier.
+func isTerminator(r rune) bool {
+	if isWhiteSpace(r) || isLineBreak(r) || isComma(r) || isPeriod(r) || isParen(r) {
+		return true
+	}
+	if strings.ContainsRune("=<>!+-*/%|:&^~?;#'\"`", r) {
+		return true
+	}
+	return false
+}
+
+func isDigit(r rune) bool {
+	if '0' <= r && r <= '9' {
+		return true
+	}
+	return false
+}
+
+func isHexDigit(r rune) bool {
+	if isDigit(r) || ('a' <= r && r <= 'f') || ('A' <= r && r <= 'F') {
+		return true
+	}
+	return false
+}
+
+func isIdentStart(r rune) bool {
+	if r == '_' || unicode.IsLetter(r) {
+		return true
+	}
+	return false
+}
+
+func isIdentPart(r rune) bool {
+	if isIdentStart(r) || unicode.IsDigit(r) {
+		return true
+	}
+	return false
+}
+
+// isWordStart reports whether r can begin a keyword or an unquoted identifier.
+// @, $ and # are accepted for variables such as @@version and temporary tables of SQL Server.
+func isWordStart(r rune) bool {
+	if isIdentStart(r) || r == '@' || r == '$' || r == '#' {
 		return true
 	}
 	return false
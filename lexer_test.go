@@ -2,13 +2,14 @@ package parser
 
 import (
 	"io"
+	"reflect"
 	"strings"
 	"testing"
 )
 
-func assertQuery(t *testing.T, query string, tokens []Token) {
+func assertQuery(t *testing.T, query string, tokens []Token, opts ...LexerOption) {
 	r := strings.NewReader(query)
-	l := NewLexer(r)
+	l := NewLexer(r, opts...)
 	for i := 0; ; i++ {
 		token, err := l.Scan()
 		if err == io.EOF && token.Type != EOF {
@@ -29,8 +30,37 @@ func assertQuery(t *testing.T, query string, tokens []Token) {
 		if token.Position.Column != tokens[i].Position.Column {
 			t.Fatalf("Failed parse query (%s). %s expected Column is %d but actually %d", query, token.Type, tokens[i].Position.Column, token.Position.Column)
 		}
-		if token.Type == IDENT && token.Value != tokens[i].Value {
-			t.Fatalf("Failed parse query (%s). expected Value is \"%s\" but actually \"%s\"", query, tokens[i].Value, token.Value)
+		if token.End != tokens[i].End {
+			t.Fatalf("Failed parse query (%s). %s expected End is %+v but actually %+v", query, token.Type, tokens[i].End, token.End)
+		}
+		switch token.Type {
+		case IDENT, QUOTED_IDENT, STRING, DECIMAL, FLOAT, SEMICOLON, DELIMITER:
+			if token.Value != tokens[i].Value {
+				t.Fatalf("Failed parse query (%s). expected Value is \"%s\" but actually \"%s\"", query, tokens[i].Value, token.Value)
+			}
+		case ILLEGAL:
+			if token.Value != tokens[i].Value || token.Text != tokens[i].Text {
+				t.Fatalf("Failed parse query (%s). expected illegal \"%s\" (%s) but actually \"%s\" (%s)", query, tokens[i].Text, tokens[i].Value, token.Text, token.Value)
+			}
+		case PARAM:
+			if token.Value != tokens[i].Value || token.IntValue != tokens[i].IntValue {
+				t.Fatalf("Failed parse query (%s). expected parameter is \"%s\" %d but actually \"%s\" %d", query, tokens[i].Value, tokens[i].IntValue, token.Value, token.IntValue)
+			}
+		case INT:
+			if token.IntValue != tokens[i].IntValue {
+				t.Fatalf("Failed parse query (%s). expected IntValue is %d but actually %d", query, tokens[i].IntValue, token.IntValue)
+			}
+		}
+	}
+}
+
+func assertTrivia(t *testing.T, expected []string, actual []Token) {
+	if len(expected) != len(actual) {
+		t.Fatalf("Expected %d trivia but got %d: %v", len(expected), len(actual), actual)
+	}
+	for i, e := range expected {
+		if actual[i].Type != COMMENT || actual[i].Value != e {
+			t.Fatalf("Expected comment \"%s\" but got %v \"%s\"", e, actual[i].Type, actual[i].Value)
 		}
 	}
 }
@@ -46,193 +76,754 @@ func TestLexer_Scan(t *testing.T) {
 			{
 				"select * from test",
 				[]Token{
-					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-					{Type: IDENT, Value: "test", Position: Position{Line: 1, Offset: 14, Column: 4}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 18}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: IDENT, Value: "test", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 18, Column: 19}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 18, Column: 19}, End: Position{Line: 1, Offset: 18, Column: 19}},
 				},
 			},
 			{
 				"select * from `test`",
 				[]Token{
-					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-					{Type: IDENT, Value: "`test`", Position: Position{Line: 1, Offset: 14, Column: 6}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 20}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: QUOTED_IDENT, Value: "test", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 20, Column: 21}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 20, Column: 21}},
 				},
 			},
 			{
 				"select    *    from    test",
 				[]Token{
-					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: ASTERISK, Position: Position{Line: 1, Offset: 10, Column: 1}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 15, Column: 4}},
-					{Type: IDENT, Value: "test", Position: Position{Line: 1, Offset: 23, Column: 4}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 27}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 10, Column: 11}, End: Position{Line: 1, Offset: 11, Column: 12}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 15, Column: 16}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: IDENT, Value: "test", Position: Position{Line: 1, Offset: 23, Column: 24}, End: Position{Line: 1, Offset: 27, Column: 28}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 27, Column: 28}, End: Position{Line: 1, Offset: 27, Column: 28}},
 				},
 			},
 			{
 				"SELECT id,age from users",
 				[]Token{
-					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 7, Column: 2}},
-					{Type: COMMA, Position: Position{Line: 1, Offset: 9, Column: 1}},
-					{Type: IDENT, Value: "age", Position: Position{Line: 1, Offset: 10, Column: 3}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 14, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 19, Column: 5}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 24}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 9, Column: 10}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 10, Column: 11}},
+					{Type: IDENT, Value: "age", Position: Position{Line: 1, Offset: 10, Column: 11}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 18, Column: 19}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 19, Column: 20}, End: Position{Line: 1, Offset: 24, Column: 25}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 24, Column: 25}, End: Position{Line: 1, Offset: 24, Column: 25}},
 				},
 			},
 			{
 				"select * from users where id = 1",
 				[]Token{
-					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-					{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 5}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 2}},
-					{Type: EQUAL, Position: Position{Line: 1, Offset: 29, Column: 1}},
-					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 31, Column: 1}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 27}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 30, Column: 31}},
+					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 32, Column: 33}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 32, Column: 33}, End: Position{Line: 1, Offset: 32, Column: 33}},
 				},
 			},
 			{
 				"select * from users where id = 1 and age = 20",
 				[]Token{
-					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-					{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 5}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 2}},
-					{Type: EQUAL, Position: Position{Line: 1, Offset: 29, Column: 1}},
-					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 31, Column: 1}},
-					{Type: AND, Position: Position{Line: 1, Offset: 33, Column: 3}},
-					{Type: IDENT, Value: "age", Position: Position{Line: 1, Offset: 37, Column: 3}},
-					{Type: EQUAL, Position: Position{Line: 1, Offset: 41, Column: 1}},
-					{Type: INT, IntValue: 20, Position: Position{Line: 1, Offset: 43, Column: 2}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 45}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 30, Column: 31}},
+					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 32, Column: 33}},
+					{Type: AND, Position: Position{Line: 1, Offset: 33, Column: 34}, End: Position{Line: 1, Offset: 36, Column: 37}},
+					{Type: IDENT, Value: "age", Position: Position{Line: 1, Offset: 37, Column: 38}, End: Position{Line: 1, Offset: 40, Column: 41}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 41, Column: 42}, End: Position{Line: 1, Offset: 42, Column: 43}},
+					{Type: INT, IntValue: 20, Position: Position{Line: 1, Offset: 43, Column: 44}, End: Position{Line: 1, Offset: 45, Column: 46}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 45, Column: 46}, End: Position{Line: 1, Offset: 45, Column: 46}},
 				},
 			},
 			{
 				"select * from users order by created_date desc",
 				[]Token{
-					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-					{Type: ORDERBY, Position: Position{Line: 1, Offset: 20, Column: 8}},
-					{Type: IDENT, Value: "created_date", Position: Position{Line: 1, Offset: 29, Column: 12}},
-					{Type: DESC, Position: Position{Line: 1, Offset: 42, Column: 4}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 46}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: ORDERBY, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: IDENT, Value: "created_date", Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 41, Column: 42}},
+					{Type: DESC, Position: Position{Line: 1, Offset: 42, Column: 43}, End: Position{Line: 1, Offset: 46, Column: 47}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 46, Column: 47}, End: Position{Line: 1, Offset: 46, Column: 47}},
 				},
 			},
 			{
 				"select * from users group by group_id having group_id > 10",
 				[]Token{
-					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-					{Type: GROUPBY, Position: Position{Line: 1, Offset: 20, Column: 8}},
-					{Type: IDENT, Value: "group_id", Position: Position{Line: 1, Offset: 29, Column: 8}},
-					{Type: HAVING, Position: Position{Line: 1, Offset: 38, Column: 6}},
-					{Type: IDENT, Value: "group_id", Position: Position{Line: 1, Offset: 45, Column: 8}},
-					{Type: GTR, Position: Position{Line: 1, Offset: 54, Column: 1}},
-					{Type: INT, Position: Position{Line: 1, Offset: 56, Column: 2}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 58}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: GROUPBY, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: IDENT, Value: "group_id", Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 37, Column: 38}},
+					{Type: HAVING, Position: Position{Line: 1, Offset: 38, Column: 39}, End: Position{Line: 1, Offset: 44, Column: 45}},
+					{Type: IDENT, Value: "group_id", Position: Position{Line: 1, Offset: 45, Column: 46}, End: Position{Line: 1, Offset: 53, Column: 54}},
+					{Type: GTR, Position: Position{Line: 1, Offset: 54, Column: 55}, End: Position{Line: 1, Offset: 55, Column: 56}},
+					{Type: INT, IntValue: 10, Position: Position{Line: 1, Offset: 56, Column: 57}, End: Position{Line: 1, Offset: 58, Column: 59}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 58, Column: 59}, End: Position{Line: 1, Offset: 58, Column: 59}},
 				},
 			},
 			{
 				"select * from users left inner join blog on users.id = blog.user_id",
 				[]Token{
-					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-					{Type: LEFT, Position: Position{Line: 1, Offset: 20, Column: 4}},
-					{Type: INNER, Position: Position{Line: 1, Offset: 25, Column: 5}},
-					{Type: JOIN, Position: Position{Line: 1, Offset: 31, Column: 4}},
-					{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 36, Column: 4}},
-					{Type: ON, Position: Position{Line: 1, Offset: 41, Column: 2}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 44, Column: 5}},
-					{Type: PERIOD, Position: Position{Line: 1, Offset: 49, Column: 1}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 50, Column: 2}},
-					{Type: EQUAL, Position: Position{Line: 1, Offset: 53, Column: 1}},
-					{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 55, Column: 4}},
-					{Type: PERIOD, Position: Position{Line: 1, Offset: 59, Column: 1}},
-					{Type: IDENT, Value: "user_id", Position: Position{Line: 1, Offset: 60, Column: 7}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 67}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: LEFT, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 24, Column: 25}},
+					{Type: INNER, Position: Position{Line: 1, Offset: 25, Column: 26}, End: Position{Line: 1, Offset: 30, Column: 31}},
+					{Type: JOIN, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 35, Column: 36}},
+					{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 36, Column: 37}, End: Position{Line: 1, Offset: 40, Column: 41}},
+					{Type: ON, Position: Position{Line: 1, Offset: 41, Column: 42}, End: Position{Line: 1, Offset: 43, Column: 44}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 44, Column: 45}, End: Position{Line: 1, Offset: 49, Column: 50}},
+					{Type: PERIOD, Position: Position{Line: 1, Offset: 49, Column: 50}, End: Position{Line: 1, Offset: 50, Column: 51}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 50, Column: 51}, End: Position{Line: 1, Offset: 52, Column: 53}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 53, Column: 54}, End: Position{Line: 1, Offset: 54, Column: 55}},
+					{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 55, Column: 56}, End: Position{Line: 1, Offset: 59, Column: 60}},
+					{Type: PERIOD, Position: Position{Line: 1, Offset: 59, Column: 60}, End: Position{Line: 1, Offset: 60, Column: 61}},
+					{Type: IDENT, Value: "user_id", Position: Position{Line: 1, Offset: 60, Column: 61}, End: Position{Line: 1, Offset: 67, Column: 68}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 67, Column: 68}, End: Position{Line: 1, Offset: 67, Column: 68}},
 				},
 			},
 			{
 				"select * from users right outer join blog on users.id = blog.user_id",
 				[]Token{
-					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-					{Type: RIGHT, Position: Position{Line: 1, Offset: 20, Column: 5}},
-					{Type: OUTER, Position: Position{Line: 1, Offset: 26, Column: 5}},
-					{Type: JOIN, Position: Position{Line: 1, Offset: 32, Column: 4}},
-					{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 37, Column: 4}},
-					{Type: ON, Position: Position{Line: 1, Offset: 42, Column: 2}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 45, Column: 5}},
-					{Type: PERIOD, Position: Position{Line: 1, Offset: 50, Column: 1}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 51, Column: 2}},
-					{Type: EQUAL, Position: Position{Line: 1, Offset: 54, Column: 1}},
-					{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 56, Column: 4}},
-					{Type: PERIOD, Position: Position{Line: 1, Offset: 60, Column: 1}},
-					{Type: IDENT, Value: "user_id", Position: Position{Line: 1, Offset: 61, Column: 7}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 68}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: RIGHT, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+					{Type: OUTER, Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 31, Column: 32}},
+					{Type: JOIN, Position: Position{Line: 1, Offset: 32, Column: 33}, End: Position{Line: 1, Offset: 36, Column: 37}},
+					{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 37, Column: 38}, End: Position{Line: 1, Offset: 41, Column: 42}},
+					{Type: ON, Position: Position{Line: 1, Offset: 42, Column: 43}, End: Position{Line: 1, Offset: 44, Column: 45}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 45, Column: 46}, End: Position{Line: 1, Offset: 50, Column: 51}},
+					{Type: PERIOD, Position: Position{Line: 1, Offset: 50, Column: 51}, End: Position{Line: 1, Offset: 51, Column: 52}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 51, Column: 52}, End: Position{Line: 1, Offset: 53, Column: 54}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 54, Column: 55}, End: Position{Line: 1, Offset: 55, Column: 56}},
+					{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 56, Column: 57}, End: Position{Line: 1, Offset: 60, Column: 61}},
+					{Type: PERIOD, Position: Position{Line: 1, Offset: 60, Column: 61}, End: Position{Line: 1, Offset: 61, Column: 62}},
+					{Type: IDENT, Value: "user_id", Position: Position{Line: 1, Offset: 61, Column: 62}, End: Position{Line: 1, Offset: 68, Column: 69}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 68, Column: 69}, End: Position{Line: 1, Offset: 68, Column: 69}},
 				},
 			},
 			{
 				"select * from users where id > 10 and age > 20",
 				[]Token{
-					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-					{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 5}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 2}},
-					{Type: GTR, Position: Position{Line: 1, Offset: 29, Column: 1}},
-					{Type: INT, IntValue: 10, Position: Position{Line: 1, Offset: 31, Column: 2}},
-					{Type: AND, Position: Position{Line: 1, Offset: 34, Column: 3}},
-					{Type: IDENT, Value: "age", Position: Position{Line: 1, Offset: 38, Column: 3}},
-					{Type: GTR, Position: Position{Line: 1, Offset: 42, Column: 1}},
-					{Type: INT, IntValue: 20, Position: Position{Line: 1, Offset: 44, Column: 2}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 46}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: GTR, Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 30, Column: 31}},
+					{Type: INT, IntValue: 10, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 33, Column: 34}},
+					{Type: AND, Position: Position{Line: 1, Offset: 34, Column: 35}, End: Position{Line: 1, Offset: 37, Column: 38}},
+					{Type: IDENT, Value: "age", Position: Position{Line: 1, Offset: 38, Column: 39}, End: Position{Line: 1, Offset: 41, Column: 42}},
+					{Type: GTR, Position: Position{Line: 1, Offset: 42, Column: 43}, End: Position{Line: 1, Offset: 43, Column: 44}},
+					{Type: INT, IntValue: 20, Position: Position{Line: 1, Offset: 44, Column: 45}, End: Position{Line: 1, Offset: 46, Column: 47}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 46, Column: 47}, End: Position{Line: 1, Offset: 46, Column: 47}},
 				},
 			},
 			{
 				"SELECT id as foo from users",
 				[]Token{
-					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 7, Column: 2}},
-					{Type: AS, Position: Position{Line: 1, Offset: 10, Column: 2}},
-					{Type: IDENT, Value: "foo", Position: Position{Line: 1, Offset: 13, Column: 3}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 17, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 22, Column: 5}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 27}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 9, Column: 10}},
+					{Type: AS, Position: Position{Line: 1, Offset: 10, Column: 11}, End: Position{Line: 1, Offset: 12, Column: 13}},
+					{Type: IDENT, Value: "foo", Position: Position{Line: 1, Offset: 13, Column: 14}, End: Position{Line: 1, Offset: 16, Column: 17}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 17, Column: 18}, End: Position{Line: 1, Offset: 21, Column: 22}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 22, Column: 23}, End: Position{Line: 1, Offset: 27, Column: 28}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 27, Column: 28}, End: Position{Line: 1, Offset: 27, Column: 28}},
 				},
 			},
 			{
 				"select * from users order by created_date desc, rank",
 				[]Token{
-					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-					{Type: ORDERBY, Position: Position{Line: 1, Offset: 20, Column: 8}},
-					{Type: IDENT, Value: "created_date", Position: Position{Line: 1, Offset: 29, Column: 12}},
-					{Type: DESC, Position: Position{Line: 1, Offset: 42, Column: 4}},
-					{Type: COMMA, Position: Position{Line: 1, Offset: 46, Column: 1}},
-					{Type: IDENT, Value: "rank", Position: Position{Line: 1, Offset: 48, Column: 4}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 52}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: ORDERBY, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: IDENT, Value: "created_date", Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 41, Column: 42}},
+					{Type: DESC, Position: Position{Line: 1, Offset: 42, Column: 43}, End: Position{Line: 1, Offset: 46, Column: 47}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 46, Column: 47}, End: Position{Line: 1, Offset: 47, Column: 48}},
+					{Type: IDENT, Value: "rank", Position: Position{Line: 1, Offset: 48, Column: 49}, End: Position{Line: 1, Offset: 52, Column: 53}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 52, Column: 53}, End: Position{Line: 1, Offset: 52, Column: 53}},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			assertQuery(t, c.Query, c.Token)
+		}
+	})
+
+	t.Run("STRING", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			Query string
+			Token []Token
+		}{
+			{
+				"select * from users where name = 'alice bob'",
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 30, Column: 31}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 32, Column: 33}},
+					{Type: STRING, Value: "alice bob", Position: Position{Line: 1, Offset: 33, Column: 34}, End: Position{Line: 1, Offset: 44, Column: 45}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 44, Column: 45}, End: Position{Line: 1, Offset: 44, Column: 45}},
+				},
+			},
+			{
+				"select * from users where name = 'it''s' and nick = 'Tom\\'s'",
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 30, Column: 31}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 32, Column: 33}},
+					{Type: STRING, Value: "it's", Position: Position{Line: 1, Offset: 33, Column: 34}, End: Position{Line: 1, Offset: 40, Column: 41}},
+					{Type: AND, Position: Position{Line: 1, Offset: 41, Column: 42}, End: Position{Line: 1, Offset: 44, Column: 45}},
+					{Type: IDENT, Value: "nick", Position: Position{Line: 1, Offset: 45, Column: 46}, End: Position{Line: 1, Offset: 49, Column: 50}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 50, Column: 51}, End: Position{Line: 1, Offset: 51, Column: 52}},
+					{Type: STRING, Value: "Tom's", Position: Position{Line: 1, Offset: 52, Column: 53}, End: Position{Line: 1, Offset: 60, Column: 61}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 60, Column: 61}, End: Position{Line: 1, Offset: 60, Column: 61}},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			assertQuery(t, c.Query, c.Token)
+		}
+	})
+
+	t.Run("STRING PostgreSQL", func(t *testing.T) {
+		t.Parallel()
+
+		l := NewLexer(strings.NewReader(`'C:\path'`), WithDialect(DialectPostgreSQL))
+		token, err := l.Scan()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token.Type != STRING || token.Value != `C:\path` {
+			t.Fatalf("Expected STRING C:\\path but got %v %s", token.Type, token.Value)
+		}
+	})
+
+	t.Run("QUOTED_IDENT", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			Query   string
+			Dialect Dialect
+			Token   []Token
+		}{
+			{
+				"select `Order` from `select`",
+				DialectMySQL,
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: QUOTED_IDENT, Value: "Order", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 14, Column: 15}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 15, Column: 16}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: QUOTED_IDENT, Value: "select", Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 28, Column: 29}, End: Position{Line: 1, Offset: 28, Column: 29}},
+				},
+			},
+			{
+				`select "Order" from "Say ""hi"""`,
+				DialectPostgreSQL,
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: QUOTED_IDENT, Value: "Order", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 14, Column: 15}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 15, Column: 16}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: QUOTED_IDENT, Value: `Say "hi"`, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 32, Column: 33}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 32, Column: 33}, End: Position{Line: 1, Offset: 32, Column: 33}},
+				},
+			},
+			{
+				"select [Order] from [Order Details]",
+				DialectSQLServer,
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: QUOTED_IDENT, Value: "Order", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 14, Column: 15}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 15, Column: 16}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: QUOTED_IDENT, Value: "Order Details", Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 35, Column: 36}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 35, Column: 36}, End: Position{Line: 1, Offset: 35, Column: 36}},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			assertQuery(t, c.Query, c.Token, WithDialect(c.Dialect))
+		}
+	})
+
+	t.Run("COMMENT", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			Query   string
+			Dialect Dialect
+			Token   []Token
+		}{
+			{
+				"select * -- all columns\nfrom /* table */ users",
+				DialectMySQL,
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: FROM, Position: Position{Line: 2, Offset: 24, Column: 1}, End: Position{Line: 2, Offset: 28, Column: 5}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 2, Offset: 41, Column: 18}, End: Position{Line: 2, Offset: 46, Column: 23}},
+					{Type: EOF, Position: Position{Line: 2, Offset: 46, Column: 23}, End: Position{Line: 2, Offset: 46, Column: 23}},
+				},
+			},
+			{
+				"select /* a\nb */ *\nfrom users",
+				DialectMySQL,
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 2, Offset: 17, Column: 6}, End: Position{Line: 2, Offset: 18, Column: 7}},
+					{Type: FROM, Position: Position{Line: 3, Offset: 19, Column: 1}, End: Position{Line: 3, Offset: 23, Column: 5}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 3, Offset: 24, Column: 6}, End: Position{Line: 3, Offset: 29, Column: 11}},
+					{Type: EOF, Position: Position{Line: 3, Offset: 29, Column: 11}, End: Position{Line: 3, Offset: 29, Column: 11}},
+				},
+			},
+			{
+				"# header\nselect * from users",
+				DialectMySQL,
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 2, Offset: 9, Column: 1}, End: Position{Line: 2, Offset: 15, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 2, Offset: 16, Column: 8}, End: Position{Line: 2, Offset: 17, Column: 9}},
+					{Type: FROM, Position: Position{Line: 2, Offset: 18, Column: 10}, End: Position{Line: 2, Offset: 22, Column: 14}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 2, Offset: 23, Column: 15}, End: Position{Line: 2, Offset: 28, Column: 20}},
+					{Type: EOF, Position: Position{Line: 2, Offset: 28, Column: 20}, End: Position{Line: 2, Offset: 28, Column: 20}},
+				},
+			},
+			{
+				"select 5--3",
+				DialectMySQL,
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: INT, IntValue: 5, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: MINUS, Position: Position{Line: 1, Offset: 8, Column: 9}, End: Position{Line: 1, Offset: 9, Column: 10}},
+					{Type: MINUS, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 10, Column: 11}},
+					{Type: INT, IntValue: 3, Position: Position{Line: 1, Offset: 10, Column: 11}, End: Position{Line: 1, Offset: 11, Column: 12}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 11, Column: 12}, End: Position{Line: 1, Offset: 11, Column: 12}},
+				},
+			},
+			{
+				"select 1 -- x",
+				DialectMySQL,
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 13, Column: 14}, End: Position{Line: 1, Offset: 13, Column: 14}},
+				},
+			},
+			{
+				"select 5--3",
+				DialectPostgreSQL,
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: INT, IntValue: 5, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 11, Column: 12}, End: Position{Line: 1, Offset: 11, Column: 12}},
+				},
+			},
+			{
+				"select /* outer /* inner */ still comment */ * from users",
+				DialectPostgreSQL,
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 45, Column: 46}, End: Position{Line: 1, Offset: 46, Column: 47}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 47, Column: 48}, End: Position{Line: 1, Offset: 51, Column: 52}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 52, Column: 53}, End: Position{Line: 1, Offset: 57, Column: 58}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 57, Column: 58}, End: Position{Line: 1, Offset: 57, Column: 58}},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			assertQuery(t, c.Query, c.Token, WithDialect(c.Dialect))
+		}
+	})
+
+	t.Run("COMMENT trivia", func(t *testing.T) {
+		t.Parallel()
+
+		l := NewLexer(strings.NewReader("-- find users\nselect * /* all */ -- columns\nfrom users"), WithTrivia())
+		expected := []struct {
+			Type     TokenType
+			Leading  []string
+			Trailing []string
+		}{
+			{Type: SELECT, Leading: []string{"-- find users"}},
+			{Type: ASTERISK, Trailing: []string{"/* all */", "-- columns"}},
+			{Type: FROM},
+			{Type: IDENT},
+		}
+		for _, e := range expected {
+			token, err := l.Scan()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if token.Type != e.Type {
+				t.Fatalf("Expected %v but got %v", e.Type, token.Type)
+			}
+			assertTrivia(t, e.Leading, token.LeadingTrivia)
+			assertTrivia(t, e.Trailing, token.TrailingTrivia)
+		}
+	})
+
+	t.Run("NUMBER", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			Query string
+			Token []Token
+		}{
+			{
+				"select 3.14, .5, 1e10, 2.5E-3, 0x1F, -7",
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: DECIMAL, Value: "3.14", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 11, Column: 12}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 11, Column: 12}, End: Position{Line: 1, Offset: 12, Column: 13}},
+					{Type: DECIMAL, Value: ".5", Position: Position{Line: 1, Offset: 13, Column: 14}, End: Position{Line: 1, Offset: 15, Column: 16}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 15, Column: 16}, End: Position{Line: 1, Offset: 16, Column: 17}},
+					{Type: FLOAT, Value: "1e10", Position: Position{Line: 1, Offset: 17, Column: 18}, End: Position{Line: 1, Offset: 21, Column: 22}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 21, Column: 22}, End: Position{Line: 1, Offset: 22, Column: 23}},
+					{Type: FLOAT, Value: "2.5E-3", Position: Position{Line: 1, Offset: 23, Column: 24}, End: Position{Line: 1, Offset: 29, Column: 30}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 30, Column: 31}},
+					{Type: INT, IntValue: 31, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 35, Column: 36}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 35, Column: 36}, End: Position{Line: 1, Offset: 36, Column: 37}},
+					{Type: MINUS, Position: Position{Line: 1, Offset: 37, Column: 38}, End: Position{Line: 1, Offset: 38, Column: 39}},
+					{Type: INT, IntValue: 7, Position: Position{Line: 1, Offset: 38, Column: 39}, End: Position{Line: 1, Offset: 39, Column: 40}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 39, Column: 40}, End: Position{Line: 1, Offset: 39, Column: 40}},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			assertQuery(t, c.Query, c.Token)
+		}
+	})
+
+	t.Run("NUMBER value", func(t *testing.T) {
+		t.Parallel()
+
+		l := NewLexer(strings.NewReader("123456789012345678901234567890 0.1000000000000000000000001"))
+		token, err := l.Scan()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token.Type != INT || token.BigIntValue == nil || token.BigIntValue.String() != "123456789012345678901234567890" {
+			t.Fatalf("Expected big INT but got %v %v", token.Type, token.BigIntValue)
+		}
+		token, err = l.Scan()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token.Type != DECIMAL || token.FloatValue != 0.1 {
+			t.Fatalf("Expected DECIMAL 0.1 but got %v %v", token.Type, token.FloatValue)
+		}
+		if token.BigFloatValue.Text('f', 25) != "0.1000000000000000000000001" {
+			t.Fatalf("Expected exact value but got %s", token.BigFloatValue.Text('f', 25))
+		}
+	})
+
+	t.Run("OPERATOR", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			Query string
+			Token []Token
+		}{
+			{
+				"a <= 1 >= <> != <=> || :: -> ->> + - / % & | ^ ~ << >>",
+				[]Token{
+					{Type: IDENT, Value: "a", Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 1, Column: 2}},
+					{Type: LEQ, Position: Position{Line: 1, Offset: 2, Column: 3}, End: Position{Line: 1, Offset: 4, Column: 5}},
+					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 5, Column: 6}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: GEQ, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 9, Column: 10}},
+					{Type: NEQ, Position: Position{Line: 1, Offset: 10, Column: 11}, End: Position{Line: 1, Offset: 12, Column: 13}},
+					{Type: NEQ, Position: Position{Line: 1, Offset: 13, Column: 14}, End: Position{Line: 1, Offset: 15, Column: 16}},
+					{Type: NULLSAFEEQUAL, Position: Position{Line: 1, Offset: 16, Column: 17}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: CONCAT, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 22, Column: 23}},
+					{Type: DOUBLECOLON, Position: Position{Line: 1, Offset: 23, Column: 24}, End: Position{Line: 1, Offset: 25, Column: 26}},
+					{Type: ARROW, Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: LONGARROW, Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 32, Column: 33}},
+					{Type: PLUS, Position: Position{Line: 1, Offset: 33, Column: 34}, End: Position{Line: 1, Offset: 34, Column: 35}},
+					{Type: MINUS, Position: Position{Line: 1, Offset: 35, Column: 36}, End: Position{Line: 1, Offset: 36, Column: 37}},
+					{Type: SLASH, Position: Position{Line: 1, Offset: 37, Column: 38}, End: Position{Line: 1, Offset: 38, Column: 39}},
+					{Type: PERCENT, Position: Position{Line: 1, Offset: 39, Column: 40}, End: Position{Line: 1, Offset: 40, Column: 41}},
+					{Type: AMPERSAND, Position: Position{Line: 1, Offset: 41, Column: 42}, End: Position{Line: 1, Offset: 42, Column: 43}},
+					{Type: PIPE, Position: Position{Line: 1, Offset: 43, Column: 44}, End: Position{Line: 1, Offset: 44, Column: 45}},
+					{Type: CARET, Position: Position{Line: 1, Offset: 45, Column: 46}, End: Position{Line: 1, Offset: 46, Column: 47}},
+					{Type: TILDE, Position: Position{Line: 1, Offset: 47, Column: 48}, End: Position{Line: 1, Offset: 48, Column: 49}},
+					{Type: SHL, Position: Position{Line: 1, Offset: 49, Column: 50}, End: Position{Line: 1, Offset: 51, Column: 52}},
+					{Type: SHR, Position: Position{Line: 1, Offset: 52, Column: 53}, End: Position{Line: 1, Offset: 54, Column: 55}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 54, Column: 55}, End: Position{Line: 1, Offset: 54, Column: 55}},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			assertQuery(t, c.Query, c.Token)
+		}
+	})
+
+	t.Run("PARAM", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			Query string
+			Token []Token
+		}{
+			{
+				"a = $1 and b = :name or c = @p1::int",
+				[]Token{
+					{Type: IDENT, Value: "a", Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 1, Column: 2}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 2, Column: 3}, End: Position{Line: 1, Offset: 3, Column: 4}},
+					{Type: PARAM, IntValue: 1, Position: Position{Line: 1, Offset: 4, Column: 5}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: AND, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 10, Column: 11}},
+					{Type: IDENT, Value: "b", Position: Position{Line: 1, Offset: 11, Column: 12}, End: Position{Line: 1, Offset: 12, Column: 13}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 13, Column: 14}, End: Position{Line: 1, Offset: 14, Column: 15}},
+					{Type: PARAM, Value: "name", Position: Position{Line: 1, Offset: 15, Column: 16}, End: Position{Line: 1, Offset: 20, Column: 21}},
+					{Type: OR, Position: Position{Line: 1, Offset: 21, Column: 22}, End: Position{Line: 1, Offset: 23, Column: 24}},
+					{Type: IDENT, Value: "c", Position: Position{Line: 1, Offset: 24, Column: 25}, End: Position{Line: 1, Offset: 25, Column: 26}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 27, Column: 28}},
+					{Type: PARAM, Value: "p1", Position: Position{Line: 1, Offset: 28, Column: 29}, End: Position{Line: 1, Offset: 31, Column: 32}},
+					{Type: DOUBLECOLON, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 33, Column: 34}},
+					{Type: INTEGER, Position: Position{Line: 1, Offset: 33, Column: 34}, End: Position{Line: 1, Offset: 36, Column: 37}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 36, Column: 37}, End: Position{Line: 1, Offset: 36, Column: 37}},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			assertQuery(t, c.Query, c.Token)
+		}
+	})
+
+	t.Run("ILLEGAL", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			Query string
+			Token []Token
+		}{
+			{
+				"select 'abc",
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ILLEGAL, Text: "'abc", Value: "unterminated string", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 11, Column: 12}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 11, Column: 12}, End: Position{Line: 1, Offset: 11, Column: 12}},
+				},
+			},
+			{
+				"order name",
+				[]Token{
+					{Type: ILLEGAL, Text: "order", Value: "ORDER is not followed by BY", Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 5, Column: 6}},
+					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 6, Column: 7}, End: Position{Line: 1, Offset: 10, Column: 11}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 10, Column: 11}, End: Position{Line: 1, Offset: 10, Column: 11}},
+				},
+			},
+			{
+				"ORDER\n BY 0x1G [a",
+				[]Token{
+					{Type: ORDERBY, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 2, Offset: 9, Column: 4}},
+					{Type: ILLEGAL, Text: "0x1G", Value: "bad number: invalid hexadecimal digit", Position: Position{Line: 2, Offset: 10, Column: 5}, End: Position{Line: 2, Offset: 14, Column: 9}},
+					{Type: ILLEGAL, Text: "[", Value: "unknown character '['", Position: Position{Line: 2, Offset: 15, Column: 10}, End: Position{Line: 2, Offset: 16, Column: 11}},
+					{Type: IDENT, Value: "a", Position: Position{Line: 2, Offset: 16, Column: 11}, End: Position{Line: 2, Offset: 17, Column: 12}},
+					{Type: EOF, Position: Position{Line: 2, Offset: 17, Column: 12}, End: Position{Line: 2, Offset: 17, Column: 12}},
+				},
+			},
+			{
+				"on duplicate\tKEY update",
+				[]Token{
+					{Type: ONDUPLICATEKEYUPDATE, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 23, Column: 24}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 23, Column: 24}, End: Position{Line: 1, Offset: 23, Column: 24}},
+				},
+			},
+			{
+				"ORDER /* c */ BY a",
+				[]Token{
+					{Type: ORDERBY, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 16, Column: 17}},
+					{Type: IDENT, Value: "a", Position: Position{Line: 1, Offset: 17, Column: 18}, End: Position{Line: 1, Offset: 18, Column: 19}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 18, Column: 19}, End: Position{Line: 1, Offset: 18, Column: 19}},
+				},
+			},
+			{
+				"group -- c\nby a",
+				[]Token{
+					{Type: GROUPBY, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 2, Offset: 13, Column: 3}},
+					{Type: IDENT, Value: "a", Position: Position{Line: 2, Offset: 14, Column: 4}, End: Position{Line: 2, Offset: 15, Column: 5}},
+					{Type: EOF, Position: Position{Line: 2, Offset: 15, Column: 5}, End: Position{Line: 2, Offset: 15, Column: 5}},
+				},
+			},
+			{
+				"primary/* k */key",
+				[]Token{
+					{Type: PRIMARYKEY, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 17, Column: 18}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 17, Column: 18}, End: Position{Line: 1, Offset: 17, Column: 18}},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			assertQuery(t, c.Query, c.Token)
+		}
+
+		assertQuery(t, "select /* x", []Token{
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ILLEGAL, Text: "/* x", Value: "unterminated block comment", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 11, Column: 12}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 11, Column: 12}, End: Position{Line: 1, Offset: 11, Column: 12}},
+		}, WithTrivia())
+	})
+
+	t.Run("UTF-8", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			Query string
+			Token []Token
+		}{
+			{
+				"select 名前 from ユーザー where 名前 = 'こんにちは😀'",
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: IDENT, Value: "名前", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 13, Column: 10}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 14, Column: 11}, End: Position{Line: 1, Offset: 18, Column: 15}},
+					{Type: IDENT, Value: "ユーザー", Position: Position{Line: 1, Offset: 19, Column: 16}, End: Position{Line: 1, Offset: 31, Column: 20}},
+					{Type: WHERE, Position: Position{Line: 1, Offset: 32, Column: 21}, End: Position{Line: 1, Offset: 37, Column: 26}},
+					{Type: IDENT, Value: "名前", Position: Position{Line: 1, Offset: 38, Column: 27}, End: Position{Line: 1, Offset: 44, Column: 29}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 45, Column: 30}, End: Position{Line: 1, Offset: 46, Column: 31}},
+					{Type: STRING, Value: "こんにちは😀", Position: Position{Line: 1, Offset: 47, Column: 32}, End: Position{Line: 1, Offset: 68, Column: 40}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 68, Column: 40}, End: Position{Line: 1, Offset: 68, Column: 40}},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			assertQuery(t, c.Query, c.Token)
+		}
+	})
+
+	t.Run("WHITESPACE", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			Query string
+			Token []Token
+		}{
+			{
+				"select\tid\r\nfrom users\rwhere id=1",
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 9, Column: 10}},
+					{Type: FROM, Position: Position{Line: 2, Offset: 11, Column: 1}, End: Position{Line: 2, Offset: 15, Column: 5}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 2, Offset: 16, Column: 6}, End: Position{Line: 2, Offset: 21, Column: 11}},
+					{Type: WHERE, Position: Position{Line: 3, Offset: 22, Column: 1}, End: Position{Line: 3, Offset: 27, Column: 6}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 3, Offset: 28, Column: 7}, End: Position{Line: 3, Offset: 30, Column: 9}},
+					{Type: EQUAL, Position: Position{Line: 3, Offset: 30, Column: 9}, End: Position{Line: 3, Offset: 31, Column: 10}},
+					{Type: INT, IntValue: 1, Position: Position{Line: 3, Offset: 31, Column: 10}, End: Position{Line: 3, Offset: 32, Column: 11}},
+					{Type: EOF, Position: Position{Line: 3, Offset: 32, Column: 11}, End: Position{Line: 3, Offset: 32, Column: 11}},
+				},
+			},
+			{
+				"select\u3000*\ffrom t",
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 9, Column: 8}, End: Position{Line: 1, Offset: 10, Column: 9}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 11, Column: 10}, End: Position{Line: 1, Offset: 15, Column: 14}},
+					{Type: IDENT, Value: "t", Position: Position{Line: 1, Offset: 16, Column: 15}, End: Position{Line: 1, Offset: 17, Column: 16}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 17, Column: 16}, End: Position{Line: 1, Offset: 17, Column: 16}},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			assertQuery(t, c.Query, c.Token)
+		}
+	})
+
+	t.Run("FOLDING", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			Folding  IdentifierFolding
+			Expected []string
+		}{
+			{FoldLower, []string{"userid", "Name", "users"}},
+			{FoldNone, []string{"UserId", "Name", "Users"}},
+			{FoldUpper, []string{"USERID", "Name", "USERS"}},
+		}
+
+		for _, c := range cases {
+			l := NewLexer(strings.NewReader("SELECT UserId, `Name` FROM Users"), WithIdentifierFolding(c.Folding))
+			idents := make([]string, 0)
+			for {
+				token, err := l.Scan()
+				if err == io.EOF {
+					break
+				}
+				if token.Type == SELECT && token.Text != "SELECT" {
+					t.Fatalf("Expected text SELECT but got %s", token.Text)
+				}
+				if token.Type == IDENT && token.Text != "UserId" && token.Text != "Users" {
+					t.Fatalf("Expected original text but got %s", token.Text)
+				}
+				if token.Type == IDENT || token.Type == QUOTED_IDENT {
+					idents = append(idents, token.Value)
+				}
+			}
+			if !reflect.DeepEqual(c.Expected, idents) {
+				t.Fatalf("Expected %v but got %v", c.Expected, idents)
+			}
+		}
+	})
+
+	t.Run("DELIMITER", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			Query string
+			Token []Token
+		}{
+			{
+				"select 1;\nDELIMITER $$\nselect 2; select 3$$",
+				[]Token{
+					{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+					{Type: SEMICOLON, Value: ";", Position: Position{Line: 1, Offset: 8, Column: 9}, End: Position{Line: 1, Offset: 9, Column: 10}},
+					{Type: DELIMITER, Value: "$$", Position: Position{Line: 2, Offset: 10, Column: 1}, End: Position{Line: 2, Offset: 22, Column: 13}},
+					{Type: SELECT, Position: Position{Line: 3, Offset: 23, Column: 1}, End: Position{Line: 3, Offset: 29, Column: 7}},
+					{Type: INT, IntValue: 2, Position: Position{Line: 3, Offset: 30, Column: 8}, End: Position{Line: 3, Offset: 31, Column: 9}},
+					{Type: SEMICOLON, Value: ";", Position: Position{Line: 3, Offset: 31, Column: 9}, End: Position{Line: 3, Offset: 32, Column: 10}},
+					{Type: SELECT, Position: Position{Line: 3, Offset: 33, Column: 11}, End: Position{Line: 3, Offset: 39, Column: 17}},
+					{Type: INT, IntValue: 3, Position: Position{Line: 3, Offset: 40, Column: 18}, End: Position{Line: 3, Offset: 41, Column: 19}},
+					{Type: SEMICOLON, Value: "$$", Position: Position{Line: 3, Offset: 41, Column: 19}, End: Position{Line: 3, Offset: 43, Column: 21}},
+					{Type: EOF, Position: Position{Line: 3, Offset: 43, Column: 21}, End: Position{Line: 3, Offset: 43, Column: 21}},
 				},
 			},
 		}
@@ -252,17 +843,69 @@ func TestLexer_Scan(t *testing.T) {
 			{
 				"update users set name = \"test\" where id = 1",
 				[]Token{
-					{Type: UPDATE, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 7, Column: 5}},
-					{Type: SET, Position: Position{Line: 1, Offset: 13, Column: 3}},
-					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 17, Column: 4}},
-					{Type: EQUAL, Position: Position{Line: 1, Offset: 22, Column: 1}},
-					{Type: IDENT, Value: "\"test\"", Position: Position{Line: 1, Offset: 24, Column: 6}},
-					{Type: WHERE, Position: Position{Line: 1, Offset: 31, Column: 5}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 37, Column: 2}},
-					{Type: EQUAL, Position: Position{Line: 1, Offset: 40, Column: 1}},
-					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 42, Column: 1}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 43}},
+					{Type: UPDATE, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 12, Column: 13}},
+					{Type: SET, Position: Position{Line: 1, Offset: 13, Column: 14}, End: Position{Line: 1, Offset: 16, Column: 17}},
+					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 17, Column: 18}, End: Position{Line: 1, Offset: 21, Column: 22}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 22, Column: 23}, End: Position{Line: 1, Offset: 23, Column: 24}},
+					{Type: STRING, Value: "test", Position: Position{Line: 1, Offset: 24, Column: 25}, End: Position{Line: 1, Offset: 30, Column: 31}},
+					{Type: WHERE, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 36, Column: 37}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 37, Column: 38}, End: Position{Line: 1, Offset: 39, Column: 40}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 40, Column: 41}, End: Position{Line: 1, Offset: 41, Column: 42}},
+					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 42, Column: 43}, End: Position{Line: 1, Offset: 43, Column: 44}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 43, Column: 44}, End: Position{Line: 1, Offset: 43, Column: 44}},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			assertQuery(t, c.Query, c.Token)
+		}
+	})
+
+	t.Run("DELETE", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			Query string
+			Token []Token
+		}{
+			{
+				"delete from users where id = 1",
+				[]Token{
+					{Type: DELETE, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 11, Column: 12}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 12, Column: 13}, End: Position{Line: 1, Offset: 17, Column: 18}},
+					{Type: WHERE, Position: Position{Line: 1, Offset: 18, Column: 19}, End: Position{Line: 1, Offset: 23, Column: 24}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 24, Column: 25}, End: Position{Line: 1, Offset: 26, Column: 27}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 27, Column: 28}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 30, Column: 31}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 30, Column: 31}, End: Position{Line: 1, Offset: 30, Column: 31}},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			assertQuery(t, c.Query, c.Token)
+		}
+	})
+
+	t.Run("DROP", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			Query string
+			Token []Token
+		}{
+			{
+				"drop table if exists users",
+				[]Token{
+					{Type: DROP, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 4, Column: 5}},
+					{Type: TABLE, Position: Position{Line: 1, Offset: 5, Column: 6}, End: Position{Line: 1, Offset: 10, Column: 11}},
+					{Type: IF, Position: Position{Line: 1, Offset: 11, Column: 12}, End: Position{Line: 1, Offset: 13, Column: 14}},
+					{Type: EXIST, Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 20, Column: 21}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 21, Column: 22}, End: Position{Line: 1, Offset: 26, Column: 27}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 26, Column: 27}},
 				},
 			},
 		}
@@ -282,43 +925,43 @@ func TestLexer_Scan(t *testing.T) {
 			{
 				"CREATE DATABASE users",
 				[]Token{
-					{Type: CREATE, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: DATABASE, Position: Position{Line: 1, Offset: 7, Column: 8}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 16, Column: 5}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 21}},
+					{Type: CREATE, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: DATABASE, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 15, Column: 16}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 16, Column: 17}, End: Position{Line: 1, Offset: 21, Column: 22}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 21, Column: 22}, End: Position{Line: 1, Offset: 21, Column: 22}},
 				},
 			},
 			{
 				"CREATE table users (id int)",
 				[]Token{
-					{Type: CREATE, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: TABLE, Position: Position{Line: 1, Offset: 7, Column: 5}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 13, Column: 5}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 19, Column: 1}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 20, Column: 2}},
-					{Type: INTEGER, Position: Position{Line: 1, Offset: 23, Column: 3}},
-					{Type: RPAREN, Position: Position{Line: 1, Offset: 26, Column: 1}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 27}},
+					{Type: CREATE, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: TABLE, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 12, Column: 13}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 13, Column: 14}, End: Position{Line: 1, Offset: 18, Column: 19}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 19, Column: 20}, End: Position{Line: 1, Offset: 20, Column: 21}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 22, Column: 23}},
+					{Type: INTEGER, Position: Position{Line: 1, Offset: 23, Column: 24}, End: Position{Line: 1, Offset: 26, Column: 27}},
+					{Type: RPAREN, Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 27, Column: 28}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 27, Column: 28}, End: Position{Line: 1, Offset: 27, Column: 28}},
 				},
 			},
 			{
 				"create assertion check_input check (not exist (select * from blog))",
 				[]Token{
-					{Type: CREATE, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: ASSERTION, Position: Position{Line: 1, Offset: 7, Column: 9}},
-					{Type: IDENT, Value: "check_input", Position: Position{Line: 1, Offset: 17, Column: 11}},
-					{Type: CHECK, Position: Position{Line: 1, Offset: 29, Column: 5}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 35, Column: 1}},
-					{Type: NOT, Position: Position{Line: 1, Offset: 36, Column: 3}},
-					{Type: EXIST, Position: Position{Line: 1, Offset: 40, Column: 5}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 46, Column: 1}},
-					{Type: SELECT, Position: Position{Line: 1, Offset: 47, Column: 6}},
-					{Type: ASTERISK, Position: Position{Line: 1, Offset: 54, Column: 1}},
-					{Type: FROM, Position: Position{Line: 1, Offset: 56, Column: 4}},
-					{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 61, Column: 4}},
-					{Type: RPAREN, Position: Position{Line: 1, Offset: 65, Column: 1}},
-					{Type: RPAREN, Position: Position{Line: 1, Offset: 66, Column: 1}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 67}},
+					{Type: CREATE, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: ASSERTION, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 16, Column: 17}},
+					{Type: IDENT, Value: "check_input", Position: Position{Line: 1, Offset: 17, Column: 18}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: CHECK, Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 34, Column: 35}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 35, Column: 36}, End: Position{Line: 1, Offset: 36, Column: 37}},
+					{Type: NOT, Position: Position{Line: 1, Offset: 36, Column: 37}, End: Position{Line: 1, Offset: 39, Column: 40}},
+					{Type: EXIST, Position: Position{Line: 1, Offset: 40, Column: 41}, End: Position{Line: 1, Offset: 45, Column: 46}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 46, Column: 47}, End: Position{Line: 1, Offset: 47, Column: 48}},
+					{Type: SELECT, Position: Position{Line: 1, Offset: 47, Column: 48}, End: Position{Line: 1, Offset: 53, Column: 54}},
+					{Type: ASTERISK, Position: Position{Line: 1, Offset: 54, Column: 55}, End: Position{Line: 1, Offset: 55, Column: 56}},
+					{Type: FROM, Position: Position{Line: 1, Offset: 56, Column: 57}, End: Position{Line: 1, Offset: 60, Column: 61}},
+					{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 61, Column: 62}, End: Position{Line: 1, Offset: 65, Column: 66}},
+					{Type: RPAREN, Position: Position{Line: 1, Offset: 65, Column: 66}, End: Position{Line: 1, Offset: 66, Column: 67}},
+					{Type: RPAREN, Position: Position{Line: 1, Offset: 66, Column: 67}, End: Position{Line: 1, Offset: 67, Column: 68}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 67, Column: 68}, End: Position{Line: 1, Offset: 67, Column: 68}},
 				},
 			},
 			{
@@ -331,56 +974,56 @@ page_id int not null,
 community_id int null,
 file varchar(20) check(file = "foo"))`,
 				[]Token{
-					{Type: CREATE, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: TABLE, Position: Position{Line: 1, Offset: 7, Column: 5}},
-					{Type: IDENT, Value: "test", Position: Position{Line: 1, Offset: 13, Column: 4}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 18, Column: 1}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 2, Offset: 20, Column: 2}},
-					{Type: SERIAL, Position: Position{Line: 2, Offset: 23, Column: 6}},
-					{Type: PRIMARYKEY, Position: Position{Line: 2, Offset: 30, Column: 11}},
-					{Type: COMMA, Position: Position{Line: 2, Offset: 41, Column: 1}},
-					{Type: IDENT, Value: "name", Position: Position{Line: 3, Offset: 43, Column: 4}},
-					{Type: VARCHAR, Position: Position{Line: 3, Offset: 48, Column: 7}},
-					{Type: LPAREN, Position: Position{Line: 3, Offset: 55, Column: 1}},
-					{Type: INT, IntValue: 4, Position: Position{Line: 3, Offset: 56, Column: 1}},
-					{Type: RPAREN, Position: Position{Line: 3, Offset: 57, Column: 1}},
-					{Type: DEFAULT, Position: Position{Line: 3, Offset: 59, Column: 7}},
-					{Type: IDENT, Value: "\"none\"", Position: Position{Line: 3, Offset: 67, Column: 6}},
-					{Type: COMMA, Position: Position{Line: 3, Offset: 73, Column: 1}},
-					{Type: IDENT, Value: "user_id", Position: Position{Line: 4, Offset: 75, Column: 7}},
-					{Type: INTEGER, Position: Position{Line: 4, Offset: 83, Column: 3}},
-					{Type: REFERENCE, Position: Position{Line: 4, Offset: 87, Column: 10}},
-					{Type: IDENT, Value: "user", Position: Position{Line: 4, Offset: 98, Column: 4}},
-					{Type: LPAREN, Position: Position{Line: 4, Offset: 102, Column: 1}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 4, Offset: 103, Column: 2}},
-					{Type: RPAREN, Position: Position{Line: 4, Offset: 105, Column: 1}},
-					{Type: COMMA, Position: Position{Line: 4, Offset: 106, Column: 1}},
-					{Type: IDENT, Value: "blog_id", Position: Position{Line: 5, Offset: 108, Column: 7}},
-					{Type: INTEGER, Position: Position{Line: 5, Offset: 116, Column: 3}},
-					{Type: UNIQUE, Position: Position{Line: 5, Offset: 120, Column: 6}},
-					{Type: COMMA, Position: Position{Line: 5, Offset: 126, Column: 1}},
-					{Type: IDENT, Value: "page_id", Position: Position{Line: 6, Offset: 128, Column: 7}},
-					{Type: INTEGER, Position: Position{Line: 6, Offset: 136, Column: 3}},
-					{Type: NOT, Position: Position{Line: 6, Offset: 140, Column: 3}},
-					{Type: NULL, Position: Position{Line: 6, Offset: 144, Column: 4}},
-					{Type: COMMA, Position: Position{Line: 6, Offset: 148, Column: 1}},
-					{Type: IDENT, Value: "community_id", Position: Position{Line: 7, Offset: 150, Column: 12}},
-					{Type: INTEGER, Position: Position{Line: 7, Offset: 163, Column: 3}},
-					{Type: NULL, Position: Position{Line: 7, Offset: 167, Column: 4}},
-					{Type: COMMA, Position: Position{Line: 7, Offset: 171, Column: 1}},
-					{Type: IDENT, Value: "file", Position: Position{Line: 8, Offset: 173, Column: 4}},
-					{Type: VARCHAR, Position: Position{Line: 8, Offset: 178, Column: 7}},
-					{Type: LPAREN, Position: Position{Line: 8, Offset: 185, Column: 1}},
-					{Type: INT, IntValue: 20, Position: Position{Line: 8, Offset: 186, Column: 2}},
-					{Type: RPAREN, Position: Position{Line: 8, Offset: 188, Column: 1}},
-					{Type: CHECK, Position: Position{Line: 8, Offset: 190, Column: 5}},
-					{Type: LPAREN, Position: Position{Line: 8, Offset: 195, Column: 1}},
-					{Type: IDENT, Value: "file", Position: Position{Line: 8, Offset: 196, Column: 4}},
-					{Type: EQUAL, Position: Position{Line: 8, Offset: 201, Column: 1}},
-					{Type: IDENT, Value: "\"foo\"", Position: Position{Line: 8, Offset: 203, Column: 5}},
-					{Type: RPAREN, Position: Position{Line: 8, Offset: 208, Column: 1}},
-					{Type: RPAREN, Position: Position{Line: 8, Offset: 209, Column: 1}},
-					{Type: EOF, Position: Position{Line: 8, Offset: 210}},
+					{Type: CREATE, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: TABLE, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 12, Column: 13}},
+					{Type: IDENT, Value: "test", Position: Position{Line: 1, Offset: 13, Column: 14}, End: Position{Line: 1, Offset: 17, Column: 18}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 18, Column: 19}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 2, Offset: 20, Column: 1}, End: Position{Line: 2, Offset: 22, Column: 3}},
+					{Type: SERIAL, Position: Position{Line: 2, Offset: 23, Column: 4}, End: Position{Line: 2, Offset: 29, Column: 10}},
+					{Type: PRIMARYKEY, Position: Position{Line: 2, Offset: 30, Column: 11}, End: Position{Line: 2, Offset: 41, Column: 22}},
+					{Type: COMMA, Position: Position{Line: 2, Offset: 41, Column: 22}, End: Position{Line: 2, Offset: 42, Column: 23}},
+					{Type: IDENT, Value: "name", Position: Position{Line: 3, Offset: 43, Column: 1}, End: Position{Line: 3, Offset: 47, Column: 5}},
+					{Type: VARCHAR, Position: Position{Line: 3, Offset: 48, Column: 6}, End: Position{Line: 3, Offset: 55, Column: 13}},
+					{Type: LPAREN, Position: Position{Line: 3, Offset: 55, Column: 13}, End: Position{Line: 3, Offset: 56, Column: 14}},
+					{Type: INT, IntValue: 4, Position: Position{Line: 3, Offset: 56, Column: 14}, End: Position{Line: 3, Offset: 57, Column: 15}},
+					{Type: RPAREN, Position: Position{Line: 3, Offset: 57, Column: 15}, End: Position{Line: 3, Offset: 58, Column: 16}},
+					{Type: DEFAULT, Position: Position{Line: 3, Offset: 59, Column: 17}, End: Position{Line: 3, Offset: 66, Column: 24}},
+					{Type: STRING, Value: "none", Position: Position{Line: 3, Offset: 67, Column: 25}, End: Position{Line: 3, Offset: 73, Column: 31}},
+					{Type: COMMA, Position: Position{Line: 3, Offset: 73, Column: 31}, End: Position{Line: 3, Offset: 74, Column: 32}},
+					{Type: IDENT, Value: "user_id", Position: Position{Line: 4, Offset: 75, Column: 1}, End: Position{Line: 4, Offset: 82, Column: 8}},
+					{Type: INTEGER, Position: Position{Line: 4, Offset: 83, Column: 9}, End: Position{Line: 4, Offset: 86, Column: 12}},
+					{Type: REFERENCE, Position: Position{Line: 4, Offset: 87, Column: 13}, End: Position{Line: 4, Offset: 97, Column: 23}},
+					{Type: IDENT, Value: "user", Position: Position{Line: 4, Offset: 98, Column: 24}, End: Position{Line: 4, Offset: 102, Column: 28}},
+					{Type: LPAREN, Position: Position{Line: 4, Offset: 102, Column: 28}, End: Position{Line: 4, Offset: 103, Column: 29}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 4, Offset: 103, Column: 29}, End: Position{Line: 4, Offset: 105, Column: 31}},
+					{Type: RPAREN, Position: Position{Line: 4, Offset: 105, Column: 31}, End: Position{Line: 4, Offset: 106, Column: 32}},
+					{Type: COMMA, Position: Position{Line: 4, Offset: 106, Column: 32}, End: Position{Line: 4, Offset: 107, Column: 33}},
+					{Type: IDENT, Value: "blog_id", Position: Position{Line: 5, Offset: 108, Column: 1}, End: Position{Line: 5, Offset: 115, Column: 8}},
+					{Type: INTEGER, Position: Position{Line: 5, Offset: 116, Column: 9}, End: Position{Line: 5, Offset: 119, Column: 12}},
+					{Type: UNIQUE, Position: Position{Line: 5, Offset: 120, Column: 13}, End: Position{Line: 5, Offset: 126, Column: 19}},
+					{Type: COMMA, Position: Position{Line: 5, Offset: 126, Column: 19}, End: Position{Line: 5, Offset: 127, Column: 20}},
+					{Type: IDENT, Value: "page_id", Position: Position{Line: 6, Offset: 128, Column: 1}, End: Position{Line: 6, Offset: 135, Column: 8}},
+					{Type: INTEGER, Position: Position{Line: 6, Offset: 136, Column: 9}, End: Position{Line: 6, Offset: 139, Column: 12}},
+					{Type: NOT, Position: Position{Line: 6, Offset: 140, Column: 13}, End: Position{Line: 6, Offset: 143, Column: 16}},
+					{Type: NULL, Position: Position{Line: 6, Offset: 144, Column: 17}, End: Position{Line: 6, Offset: 148, Column: 21}},
+					{Type: COMMA, Position: Position{Line: 6, Offset: 148, Column: 21}, End: Position{Line: 6, Offset: 149, Column: 22}},
+					{Type: IDENT, Value: "community_id", Position: Position{Line: 7, Offset: 150, Column: 1}, End: Position{Line: 7, Offset: 162, Column: 13}},
+					{Type: INTEGER, Position: Position{Line: 7, Offset: 163, Column: 14}, End: Position{Line: 7, Offset: 166, Column: 17}},
+					{Type: NULL, Position: Position{Line: 7, Offset: 167, Column: 18}, End: Position{Line: 7, Offset: 171, Column: 22}},
+					{Type: COMMA, Position: Position{Line: 7, Offset: 171, Column: 22}, End: Position{Line: 7, Offset: 172, Column: 23}},
+					{Type: IDENT, Value: "file", Position: Position{Line: 8, Offset: 173, Column: 1}, End: Position{Line: 8, Offset: 177, Column: 5}},
+					{Type: VARCHAR, Position: Position{Line: 8, Offset: 178, Column: 6}, End: Position{Line: 8, Offset: 185, Column: 13}},
+					{Type: LPAREN, Position: Position{Line: 8, Offset: 185, Column: 13}, End: Position{Line: 8, Offset: 186, Column: 14}},
+					{Type: INT, IntValue: 20, Position: Position{Line: 8, Offset: 186, Column: 14}, End: Position{Line: 8, Offset: 188, Column: 16}},
+					{Type: RPAREN, Position: Position{Line: 8, Offset: 188, Column: 16}, End: Position{Line: 8, Offset: 189, Column: 17}},
+					{Type: CHECK, Position: Position{Line: 8, Offset: 190, Column: 18}, End: Position{Line: 8, Offset: 195, Column: 23}},
+					{Type: LPAREN, Position: Position{Line: 8, Offset: 195, Column: 23}, End: Position{Line: 8, Offset: 196, Column: 24}},
+					{Type: IDENT, Value: "file", Position: Position{Line: 8, Offset: 196, Column: 24}, End: Position{Line: 8, Offset: 200, Column: 28}},
+					{Type: EQUAL, Position: Position{Line: 8, Offset: 201, Column: 29}, End: Position{Line: 8, Offset: 202, Column: 30}},
+					{Type: STRING, Value: "foo", Position: Position{Line: 8, Offset: 203, Column: 31}, End: Position{Line: 8, Offset: 208, Column: 36}},
+					{Type: RPAREN, Position: Position{Line: 8, Offset: 208, Column: 36}, End: Position{Line: 8, Offset: 209, Column: 37}},
+					{Type: RPAREN, Position: Position{Line: 8, Offset: 209, Column: 37}, End: Position{Line: 8, Offset: 210, Column: 38}},
+					{Type: EOF, Position: Position{Line: 8, Offset: 210, Column: 38}, End: Position{Line: 8, Offset: 210, Column: 38}},
 				},
 			},
 		}
@@ -400,17 +1043,17 @@ file varchar(20) check(file = "foo"))`,
 			{
 				"alter table users add column name varchar(255)",
 				[]Token{
-					{Type: ALTER, Position: Position{Line: 1, Offset: 0, Column: 5}},
-					{Type: TABLE, Position: Position{Line: 1, Offset: 6, Column: 5}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 12, Column: 5}},
-					{Type: ADD, Position: Position{Line: 1, Offset: 18, Column: 3}},
-					{Type: COLUMN, Position: Position{Line: 1, Offset: 22, Column: 6}},
-					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 29, Column: 4}},
-					{Type: VARCHAR, Position: Position{Line: 1, Offset: 34, Column: 7}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 41, Column: 1}},
-					{Type: INT, IntValue: 255, Position: Position{Line: 1, Offset: 42, Column: 3}},
-					{Type: RPAREN, Position: Position{Line: 1, Offset: 45, Column: 1}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 46}},
+					{Type: ALTER, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 5, Column: 6}},
+					{Type: TABLE, Position: Position{Line: 1, Offset: 6, Column: 7}, End: Position{Line: 1, Offset: 11, Column: 12}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 12, Column: 13}, End: Position{Line: 1, Offset: 17, Column: 18}},
+					{Type: ADD, Position: Position{Line: 1, Offset: 18, Column: 19}, End: Position{Line: 1, Offset: 21, Column: 22}},
+					{Type: COLUMN, Position: Position{Line: 1, Offset: 22, Column: 23}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 33, Column: 34}},
+					{Type: VARCHAR, Position: Position{Line: 1, Offset: 34, Column: 35}, End: Position{Line: 1, Offset: 41, Column: 42}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 41, Column: 42}, End: Position{Line: 1, Offset: 42, Column: 43}},
+					{Type: INT, IntValue: 255, Position: Position{Line: 1, Offset: 42, Column: 43}, End: Position{Line: 1, Offset: 45, Column: 46}},
+					{Type: RPAREN, Position: Position{Line: 1, Offset: 45, Column: 46}, End: Position{Line: 1, Offset: 46, Column: 47}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 46, Column: 47}, End: Position{Line: 1, Offset: 46, Column: 47}},
 				},
 			},
 		}
@@ -430,88 +1073,88 @@ file varchar(20) check(file = "foo"))`,
 			{
 				"insert into users values (1, \"test\")",
 				[]Token{
-					{Type: INSERT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: INTO, Position: Position{Line: 1, Offset: 7, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 12, Column: 5}},
-					{Type: IDENT, Value: "values", Position: Position{Line: 1, Offset: 18, Column: 6}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 25, Column: 1}},
-					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 26, Column: 1}},
-					{Type: COMMA, Position: Position{Line: 1, Offset: 27, Column: 1}},
-					{Type: IDENT, Value: "\"test\"", Position: Position{Line: 1, Offset: 29, Column: 6}},
-					{Type: RPAREN, Position: Position{Line: 1, Offset: 35, Column: 1}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 36}},
+					{Type: INSERT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: INTO, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 11, Column: 12}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 12, Column: 13}, End: Position{Line: 1, Offset: 17, Column: 18}},
+					{Type: IDENT, Value: "values", Position: Position{Line: 1, Offset: 18, Column: 19}, End: Position{Line: 1, Offset: 24, Column: 25}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 25, Column: 26}, End: Position{Line: 1, Offset: 26, Column: 27}},
+					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 27, Column: 28}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 27, Column: 28}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: STRING, Value: "test", Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 35, Column: 36}},
+					{Type: RPAREN, Position: Position{Line: 1, Offset: 35, Column: 36}, End: Position{Line: 1, Offset: 36, Column: 37}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 36, Column: 37}, End: Position{Line: 1, Offset: 36, Column: 37}},
 				},
 			},
 			{
 				"insert into users (id, name) values (1, \"test\")",
 				[]Token{
-					{Type: INSERT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: INTO, Position: Position{Line: 1, Offset: 7, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 12, Column: 5}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 18, Column: 1}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 19, Column: 2}},
-					{Type: COMMA, Position: Position{Line: 1, Offset: 21, Column: 1}},
-					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 23, Column: 4}},
-					{Type: RPAREN, Position: Position{Line: 1, Offset: 27, Column: 1}},
-					{Type: IDENT, Value: "values", Position: Position{Line: 1, Offset: 29, Column: 6}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 36, Column: 1}},
-					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 37, Column: 1}},
-					{Type: COMMA, Position: Position{Line: 1, Offset: 38, Column: 1}},
-					{Type: IDENT, Value: "\"test\"", Position: Position{Line: 1, Offset: 40, Column: 6}},
-					{Type: RPAREN, Position: Position{Line: 1, Offset: 46, Column: 1}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 47}},
+					{Type: INSERT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: INTO, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 11, Column: 12}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 12, Column: 13}, End: Position{Line: 1, Offset: 17, Column: 18}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 18, Column: 19}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 19, Column: 20}, End: Position{Line: 1, Offset: 21, Column: 22}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 21, Column: 22}, End: Position{Line: 1, Offset: 22, Column: 23}},
+					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 23, Column: 24}, End: Position{Line: 1, Offset: 27, Column: 28}},
+					{Type: RPAREN, Position: Position{Line: 1, Offset: 27, Column: 28}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: IDENT, Value: "values", Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 35, Column: 36}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 36, Column: 37}, End: Position{Line: 1, Offset: 37, Column: 38}},
+					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 37, Column: 38}, End: Position{Line: 1, Offset: 38, Column: 39}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 38, Column: 39}, End: Position{Line: 1, Offset: 39, Column: 40}},
+					{Type: STRING, Value: "test", Position: Position{Line: 1, Offset: 40, Column: 41}, End: Position{Line: 1, Offset: 46, Column: 47}},
+					{Type: RPAREN, Position: Position{Line: 1, Offset: 46, Column: 47}, End: Position{Line: 1, Offset: 47, Column: 48}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 47, Column: 48}, End: Position{Line: 1, Offset: 47, Column: 48}},
 				},
 			},
 			{
 				"insert into users (id, name) values (1, \"test\"), (2, \"foo\")",
 				[]Token{
-					{Type: INSERT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: INTO, Position: Position{Line: 1, Offset: 7, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 12, Column: 5}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 18, Column: 1}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 19, Column: 2}},
-					{Type: COMMA, Position: Position{Line: 1, Offset: 21, Column: 1}},
-					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 23, Column: 4}},
-					{Type: RPAREN, Position: Position{Line: 1, Offset: 27, Column: 1}},
-					{Type: IDENT, Value: "values", Position: Position{Line: 1, Offset: 29, Column: 6}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 36, Column: 1}},
-					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 37, Column: 1}},
-					{Type: COMMA, Position: Position{Line: 1, Offset: 38, Column: 1}},
-					{Type: IDENT, Value: "\"test\"", Position: Position{Line: 1, Offset: 40, Column: 6}},
-					{Type: RPAREN, Position: Position{Line: 1, Offset: 46, Column: 1}},
-					{Type: COMMA, Position: Position{Line: 1, Offset: 47, Column: 1}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 49, Column: 1}},
-					{Type: INT, IntValue: 2, Position: Position{Line: 1, Offset: 50, Column: 1}},
-					{Type: COMMA, Position: Position{Line: 1, Offset: 51, Column: 1}},
-					{Type: IDENT, Value: "\"foo\"", Position: Position{Line: 1, Offset: 53, Column: 5}},
-					{Type: RPAREN, Position: Position{Line: 1, Offset: 58, Column: 1}},
-					{Type: EOF, Position: Position{Line: 1, Offset: 59}},
+					{Type: INSERT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: INTO, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 11, Column: 12}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 12, Column: 13}, End: Position{Line: 1, Offset: 17, Column: 18}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 18, Column: 19}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 19, Column: 20}, End: Position{Line: 1, Offset: 21, Column: 22}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 21, Column: 22}, End: Position{Line: 1, Offset: 22, Column: 23}},
+					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 23, Column: 24}, End: Position{Line: 1, Offset: 27, Column: 28}},
+					{Type: RPAREN, Position: Position{Line: 1, Offset: 27, Column: 28}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: IDENT, Value: "values", Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 35, Column: 36}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 36, Column: 37}, End: Position{Line: 1, Offset: 37, Column: 38}},
+					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 37, Column: 38}, End: Position{Line: 1, Offset: 38, Column: 39}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 38, Column: 39}, End: Position{Line: 1, Offset: 39, Column: 40}},
+					{Type: STRING, Value: "test", Position: Position{Line: 1, Offset: 40, Column: 41}, End: Position{Line: 1, Offset: 46, Column: 47}},
+					{Type: RPAREN, Position: Position{Line: 1, Offset: 46, Column: 47}, End: Position{Line: 1, Offset: 47, Column: 48}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 47, Column: 48}, End: Position{Line: 1, Offset: 48, Column: 49}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 49, Column: 50}, End: Position{Line: 1, Offset: 50, Column: 51}},
+					{Type: INT, IntValue: 2, Position: Position{Line: 1, Offset: 50, Column: 51}, End: Position{Line: 1, Offset: 51, Column: 52}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 51, Column: 52}, End: Position{Line: 1, Offset: 52, Column: 53}},
+					{Type: STRING, Value: "foo", Position: Position{Line: 1, Offset: 53, Column: 54}, End: Position{Line: 1, Offset: 58, Column: 59}},
+					{Type: RPAREN, Position: Position{Line: 1, Offset: 58, Column: 59}, End: Position{Line: 1, Offset: 59, Column: 60}},
+					{Type: EOF, Position: Position{Line: 1, Offset: 59, Column: 60}, End: Position{Line: 1, Offset: 59, Column: 60}},
 				},
 			},
 			{
 				"insert into users (id, name) values (1, \"test\") on duplicate key update name = values(name)",
 				[]Token{
-					{Type: INSERT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-					{Type: INTO, Position: Position{Line: 1, Offset: 7, Column: 4}},
-					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 12, Column: 5}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 18, Column: 1}},
-					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 19, Column: 2}},
-					{Type: COMMA, Position: Position{Line: 1, Offset: 21, Column: 1}},
-					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 23, Column: 4}},
-					{Type: RPAREN, Position: Position{Line: 1, Offset: 27, Column: 1}},
-					{Type: IDENT, Value: "values", Position: Position{Line: 1, Offset: 29, Column: 6}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 36, Column: 1}},
-					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 37, Column: 1}},
-					{Type: COMMA, Position: Position{Line: 1, Offset: 38, Column: 1}},
-					{Type: IDENT, Value: "\"test\"", Position: Position{Line: 1, Offset: 40, Column: 6}},
-					{Type: RPAREN, Position: Position{Line: 1, Offset: 46, Column: 1}},
-					{Type: ONDUPLICATEKEYUPDATE, Position: Position{Line: 1, Offset: 48, Column: 23}},
-					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 72, Column: 4}},
-					{Type: EQUAL, Position: Position{Line: 1, Offset: 77, Column: 1}},
-					{Type: IDENT, Value: "values", Position: Position{Line: 1, Offset: 79, Column: 6}},
-					{Type: LPAREN, Position: Position{Line: 1, Offset: 85, Column: 1}},
-					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 86, Column: 4}},
-					{Type: RPAREN, Position: Position{Line: 1, Offset: 90, Column: 1}},
+					{Type: INSERT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+					{Type: INTO, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 11, Column: 12}},
+					{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 12, Column: 13}, End: Position{Line: 1, Offset: 17, Column: 18}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 18, Column: 19}, End: Position{Line: 1, Offset: 19, Column: 20}},
+					{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 19, Column: 20}, End: Position{Line: 1, Offset: 21, Column: 22}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 21, Column: 22}, End: Position{Line: 1, Offset: 22, Column: 23}},
+					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 23, Column: 24}, End: Position{Line: 1, Offset: 27, Column: 28}},
+					{Type: RPAREN, Position: Position{Line: 1, Offset: 27, Column: 28}, End: Position{Line: 1, Offset: 28, Column: 29}},
+					{Type: IDENT, Value: "values", Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 35, Column: 36}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 36, Column: 37}, End: Position{Line: 1, Offset: 37, Column: 38}},
+					{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 37, Column: 38}, End: Position{Line: 1, Offset: 38, Column: 39}},
+					{Type: COMMA, Position: Position{Line: 1, Offset: 38, Column: 39}, End: Position{Line: 1, Offset: 39, Column: 40}},
+					{Type: STRING, Value: "test", Position: Position{Line: 1, Offset: 40, Column: 41}, End: Position{Line: 1, Offset: 46, Column: 47}},
+					{Type: RPAREN, Position: Position{Line: 1, Offset: 46, Column: 47}, End: Position{Line: 1, Offset: 47, Column: 48}},
+					{Type: ONDUPLICATEKEYUPDATE, Position: Position{Line: 1, Offset: 48, Column: 49}, End: Position{Line: 1, Offset: 71, Column: 72}},
+					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 72, Column: 73}, End: Position{Line: 1, Offset: 76, Column: 77}},
+					{Type: EQUAL, Position: Position{Line: 1, Offset: 77, Column: 78}, End: Position{Line: 1, Offset: 78, Column: 79}},
+					{Type: IDENT, Value: "values", Position: Position{Line: 1, Offset: 79, Column: 80}, End: Position{Line: 1, Offset: 85, Column: 86}},
+					{Type: LPAREN, Position: Position{Line: 1, Offset: 85, Column: 86}, End: Position{Line: 1, Offset: 86, Column: 87}},
+					{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 86, Column: 87}, End: Position{Line: 1, Offset: 90, Column: 91}},
+					{Type: RPAREN, Position: Position{Line: 1, Offset: 90, Column: 91}, End: Position{Line: 1, Offset: 91, Column: 92}},
 				},
 			},
 		}
@@ -521,3 +1164,36 @@ file varchar(20) check(file = "foo"))`,
 		}
 	})
 }
+
+func TestLexerReader(t *testing.T) {
+	query := "select id, name from users where id = 1"
+	expected := scanTokens(t, query)
+
+	tr := NewLexerReader(NewLexer(strings.NewReader(query)))
+	peeked, err := tr.Peek(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, token := range peeked {
+		if token.Type != expected[i].Type {
+			t.Fatalf("Expected %v but got %v", expected[i].Type, token.Type)
+		}
+	}
+	if _, err := tr.Peek(len(expected) + 1); err != io.EOF {
+		t.Fatalf("Expected io.EOF but got %v", err)
+	}
+
+	tr.Discard(2)
+	for i := 2; i < len(expected); i++ {
+		token, err := tr.Scan()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token.Type != expected[i].Type || token.Position != expected[i].Position {
+			t.Fatalf("Expected %v at %+v but got %v at %+v", expected[i].Type, expected[i].Position, token.Type, token.Position)
+		}
+	}
+	if _, err := tr.Scan(); err != io.EOF {
+		t.Fatalf("Expected io.EOF but got %v", err)
+	}
+}
@@ -3,6 +3,8 @@ package parser
 import (
 	"errors"
 	"io"
+	"math/big"
+	"strings"
 )
 
 var (
@@ -12,6 +14,8 @@ var (
 // Select
 // Query: SELECT * FROM test
 //		  SELECT id, name FROM test
+//		  SELECT id FROM test ORDER BY id LIMIT 10
+// <query expression> ::= <query specification> [ <order by clause> ] [ LIMIT <value expression> ]
 // <query specification> ::= SELECT [ <set quantifier> ] <select list> <table expression>
 // <set quantifier> ::= DISTINCT | ALL
 // <select list> ::= <asterisk> | <select sublist> [ { <comma> <select sublist> }... ]
@@ -50,18 +54,52 @@ var (
 //	|	[ ROW ] <left paren> <row value constructor element list> <right paren>
 //	|	<row subquery>
 // <row value constructor element> ::= <value expression>
+// <null predicate> ::= <row value expression> IS [ NOT ] NULL
 // <between predicate> ::=
 //		<row value expression> [ NOT ] BETWEEN [ ASYMMETRIC | SYMMETRIC ]
 //		<row value expression> AND <row value expression>
 
 type Tokens []Token
 
-type Query interface{}
+// Node is implemented by all nodes of the AST. Pos and End are the span of the node in the source.
+type Node interface {
+	Pos() Position
+	End() Position
+}
+
+// Statement is implemented by the statement nodes such as *Select.
+type Statement interface {
+	Node
+	statementNode()
+}
+
+// Expr is implemented by the expression nodes such as *ComparisonExpr and ValueExpr.
+type Expr interface {
+	Node
+	exprNode()
+}
+
+// Span is the range of the source of a node. It is embedded in the nodes to implement Node.
+type Span struct {
+	StartPos Position
+	EndPos   Position
+}
+
+func (s Span) Pos() Position {
+	return s.StartPos
+}
+
+func (s Span) End() Position {
+	return s.EndPos
+}
 
 type Select struct {
+	Span
 	SelectList SelectList
 	Table      TableExpression
 	OrderBy    OrderByClause
+	// Limit is nil when the query has no LIMIT.
+	Limit Expr
 }
 
 type OrderByClause []*SortSpecification
@@ -73,10 +111,16 @@ type SortSpecification struct {
 
 type SelectList []SelectExpr
 
+// SelectExpr is an element of a select list. Column is set for a column name and Expr is set for other expressions
+// such as count(*) and price * 2. Asterisk is true for * and for a qualified asterisk such as users.* whose
+// qualifier is Qualifier.
 type SelectExpr struct {
-	Column   string
-	Alias    string
-	Asterisk bool
+	Span
+	Column    string
+	Expr      Expr
+	Alias     string
+	Asterisk  bool
+	Qualifier []string
 }
 
 type TableExpression struct {
@@ -88,7 +132,8 @@ type TableExpression struct {
 
 type FromClause struct {
 	Table TableList
-	Join  JoinedTable
+	// Join is the joined tables in order. It is nil when there is no JOIN.
+	Join []JoinedTable
 }
 
 type JoinedTable struct {
@@ -112,15 +157,13 @@ type ColumnList Tokens
 type TableList []TableReference
 
 type TableReference struct {
+	Span
 	Name  string
 	Alias string
 }
 
-type Expr interface{}
-
-type SearchCondition struct{}
-
 type BooleanTerm struct {
+	Span
 	Boolean Token
 	Left    Expr
 	Right   Expr
@@ -130,37 +173,115 @@ const (
 	ComparisonOperatorEqual = iota
 	ComparisonOperatorLessThan
 	ComparisonOperatorGreaterThan
+	ComparisonOperatorLessThanOrEqual
+	ComparisonOperatorGreaterThanOrEqual
+	ComparisonOperatorNotEqual
+	ComparisonOperatorNullSafeEqual // <=>
 )
 
 type ComparisonOperator int
 
 type ComparisonExpr struct {
+	Span
 	Operator   ComparisonOperator
 	LeftValue  ValueExpr
 	RightValue ValueExpr
 }
 
+// NullPredicate is IS NULL or IS NOT NULL.
+type NullPredicate struct {
+	Span
+	Value ValueExpr
+	Not   bool
+}
+
 const (
-	ValueTypeInt = iota
-	ValueTypeString
-	ValueTypeParameter
+	ValueTypeInt              = iota
+	ValueTypeString           // string literal
+	ValueTypeParameter        // column reference. e.g. id, users.id
 	ValueTypeDynamicParameter // ?
+	ValueTypeDecimal          // 3.14
+	ValueTypeFloat            // 1e10
+	ValueTypeNamedParameter   // :name, @name
+	ValueTypeNull             // NULL
+	ValueTypeDefault          // DEFAULT in VALUES and SET
 )
 
 type ValueType int
 
 type ValueExpr struct {
+	Span
 	Type        ValueType
 	IntValue    int
 	StringValue string
 	Identifiers []string
+	FloatValue  float64
+	// BigIntValue is set instead of IntValue when the integer overflows int.
+	BigIntValue   *big.Int
+	BigFloatValue *big.Float
+	// ParameterIndex is the 1-based ordinal of a dynamic parameter. ParameterName is the name of a named parameter.
+	ParameterIndex int
+	ParameterName  string
 }
 
+// RawValue is a token which is an argument of a function call but not a value such as * of count(*).
 type RawValue struct {
+	Span
 	Token Token
 }
 
-type Parser struct{}
+// FuncCall is a function call such as VALUES(name) and NOW().
+type FuncCall struct {
+	Span
+	Name string
+	Args []Expr
+}
+
+// ArithmeticExpr is a binary arithmetic expression such as n + 1. Operator is PLUS, MINUS, ASTERISK, SLASH, PERCENT
+// or CONCAT of the string concatenation a || b.
+type ArithmeticExpr struct {
+	Span
+	Operator Token
+	Left     Expr
+	Right    Expr
+}
+
+// Assignment is col = expr of SET and ON DUPLICATE KEY UPDATE. Column is the identifiers of a column reference.
+type Assignment struct {
+	Span
+	Column []string
+	Value  Expr
+}
+
+func (*Select) statementNode() {}
+
+func (*BooleanTerm) exprNode()    {}
+func (*ComparisonExpr) exprNode() {}
+func (*NullPredicate) exprNode()  {}
+func (ValueExpr) exprNode()       {}
+func (*RawValue) exprNode()       {}
+func (*FuncCall) exprNode()       {}
+func (*ArithmeticExpr) exprNode() {}
+
+type Parser struct {
+	// ContinueOnError makes ParseScript keep parsing the following statements after a statement failed to parse.
+	ContinueOnError bool
+	// Source is the text which the tokens are scanned from. When it is set, ParseError has the excerpt of the source.
+	Source string
+
+	// placeholders is the number of ? in the statement.
+	placeholders int
+	// reader is the TokenReader of the statement which is being parsed.
+	reader *positionReader
+}
+
+// ScriptStatement is a statement of a script. Start and End are the span of the statement without the delimiter.
+type ScriptStatement struct {
+	Query Statement
+	Start Position
+	End   Position
+	Err   error
+}
 
 type TokenReader interface {
 	Scan() (Token, error)
@@ -168,29 +289,156 @@ type TokenReader interface {
 	Discard(n int)
 }
 
-func (p *Parser) Parse(tokens TokenReader) (Query, error) {
+// positionReader records the last token read from the TokenReader so that the parser knows the end of a node.
+// It also records the first ILLEGAL token so that the error of the lexer is not lost when the parser skips the token.
+type positionReader struct {
+	TokenReader
+	last    Token
+	illegal *Token
+}
+
+func (r *positionReader) Scan() (Token, error) {
+	t, err := r.TokenReader.Scan()
+	if err == nil {
+		r.record(t)
+	}
+	return t, err
+}
+
+func (r *positionReader) Discard(n int) {
+	if t, err := r.Peek(n); err == nil {
+		for _, t := range t {
+			r.record(t)
+		}
+	}
+	r.TokenReader.Discard(n)
+}
+
+func (r *positionReader) record(t Token) {
+	if t.Type == ILLEGAL && r.illegal == nil {
+		r.illegal = &t
+	}
+	if t.Type != EOF {
+		r.last = t
+	}
+}
+
+// Parse parses a statement of sql. A delimiter such as ; at the end of sql is ignored.
+// The error is a *ParseError which has the excerpt of sql.
+func Parse(sql string, opts ...LexerOption) (Statement, error) {
+	p := &Parser{Source: sql}
+	return p.Parse(&trailingDelimiterReader{TokenReader: NewLexerReader(NewLexer(strings.NewReader(sql), opts...))})
+}
+
+// ParseReader parses a statement read from r. Like Parse, a delimiter at the end of the input is ignored. The tokens are scanned on demand and the source is not retained,
+// so a *ParseError returned by ParseReader has no excerpt.
+func ParseReader(r io.Reader, opts ...LexerOption) (Statement, error) {
+	p := &Parser{}
+	return p.Parse(&trailingDelimiterReader{TokenReader: NewLexerReader(NewLexer(r, opts...))})
+}
+
+func (p *Parser) Parse(tokens TokenReader) (Statement, error) {
+	p.placeholders = 0
+	p.reader = &positionReader{TokenReader: tokens}
+	s, err := p.parseStatement(p.reader)
+	// The error of the lexer precedes the error which the ILLEGAL token causes.
+	if t := p.reader.illegal; t != nil {
+		if e, ok := err.(*ParseError); ok && e.Position == t.Position {
+			return nil, err
+		}
+		return nil, p.unexpected(*t)
+	}
+
+	return s, err
+}
+
+func (p *Parser) parseStatement(tokens TokenReader) (Statement, error) {
 	t, err := tokens.Peek(1)
 	if err != nil {
-		return nil, ErrInvalidQuery
+		return nil, p.unexpected(Token{Type: EOF}, statementTokenTypes...)
 	}
 
 	switch t[0].Type {
 	case SELECT:
-		return p.parseSelect(tokens)
+		s, err := p.parseSelect(tokens)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectEnd(tokens); err != nil {
+			return nil, err
+		}
+		return s, nil
+	case INSERT:
+		return p.parseInsert(tokens)
+	case UPDATE:
+		return p.parseUpdate(tokens)
+	case DELETE:
+		return p.parseDelete(tokens)
+	case CREATE:
+		return p.parseCreate(tokens)
+	case ALTER:
+		return p.parseAlterTable(tokens)
+	case DROP:
+		return p.parseDrop(tokens)
+	}
+	// Some statements begin with an unreserved keyword. TRUNCATE is not reserved because MySQL has the TRUNCATE function.
+	switch {
+	case isKeyword(t[0], "truncate"):
+		return p.parseTruncate(tokens)
+	case isKeyword(t[0], "refresh"):
+		return p.parseRefreshMaterializedView(tokens)
 	}
 
-	return nil, ErrInvalidQuery
+	return nil, p.unexpected(t[0], statementTokenTypes...)
 }
 
-func (p *Parser) parseSelect(tokens TokenReader) (Query, error) {
-	if t, err := tokens.Peek(1); err != nil || t[0].Type != SELECT {
-		return nil, ErrInvalidQuery
-	} else {
-		tokens.Discard(1)
+// ParseScript parses statements separated by semicolons or by the delimiter which is changed by the DELIMITER command.
+// When the parser fails to parse a statement, ParseScript returns the statements parsed so far with the error
+// unless ContinueOnError is set. In that case the error is stored to Err of the statement.
+func (p *Parser) ParseScript(tokens TokenReader) ([]*ScriptStatement, error) {
+	res := make([]*ScriptStatement, 0)
+	delimiter := ";"
+	statement := make(Tokens, 0)
+	for {
+		t, err := tokens.Scan()
+		if err != nil && err != io.EOF {
+			return res, err
+		}
+		eof := err == io.EOF || t.Type == EOF
+
+		if !eof && t.Type != DELIMITER && !(t.Type == SEMICOLON && t.Value == delimiter) {
+			statement = append(statement, t)
+			continue
+		}
+
+		if len(statement) > 0 {
+			s := &ScriptStatement{Start: statement[0].Position, End: statement[len(statement)-1].End}
+			statement = append(statement, Token{Type: EOF, Position: t.Position, End: t.Position})
+			s.Query, s.Err = p.Parse(NewTokensReader(statement))
+			res = append(res, s)
+			if s.Err != nil && !p.ContinueOnError {
+				return res, s.Err
+			}
+			statement = make(Tokens, 0)
+		}
+		if t.Type == DELIMITER {
+			delimiter = t.Value
+		}
+		if eof {
+			return res, nil
+		}
 	}
+}
+
+func (p *Parser) parseSelect(tokens TokenReader) (Statement, error) {
 	query := NewSelect()
+	start, err := p.expect(tokens, SELECT)
+	if err != nil {
+		return nil, err
+	}
+	query.StartPos = start.Position
 
-	selectList, err := p.parseSelectList(tokens)
+	selectList, err := p.parseSelectList(start, tokens)
 	if err != nil && err != io.EOF {
 		return nil, err
 	}
@@ -202,6 +450,7 @@ func (p *Parser) parseSelect(tokens TokenReader) (Query, error) {
 	}
 	query.Table = tableExpression
 	if err == io.EOF {
+		query.EndPos = p.reader.last.End
 		return query, nil
 	}
 
@@ -211,6 +460,13 @@ func (p *Parser) parseSelect(tokens TokenReader) (Query, error) {
 	}
 	query.OrderBy = orderByClause
 
+	limit, err := p.parseLimitClause(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.Limit = limit
+	query.EndPos = p.reader.last.End
+
 	return query, nil
 }
 
@@ -222,26 +478,11 @@ func (p *Parser) parseOrderByClause(tokens TokenReader) (OrderByClause, error) {
 	}
 
 	res := make([]*SortSpecification, 0)
-	left := make(Tokens, 0, 2)
-	for {
-		t, err := tokens.Peek(1)
-		if err != nil && err != io.EOF {
-			return OrderByClause{}, nil
-		}
-		if err == io.EOF {
-			res = append(res, p.parseSortSpecification(left))
-			break
-		}
-
-		tokens.Discard(1)
-		switch t[0].Type {
-		case COMMA:
-			res = append(res, p.parseSortSpecification(left))
-			left = make(Tokens, 0, 2)
-			continue
-		default:
-			left = append(left, t[0])
+	for _, spec := range splitComma(p.collect(tokens, func(t Token) bool { return t.Type == LIMIT })) {
+		if len(spec) == 0 {
+			return OrderByClause{}, p.unexpected(peekToken(tokens), IDENT, QUOTED_IDENT)
 		}
+		res = append(res, p.parseSortSpecification(spec))
 	}
 
 	return OrderByClause(res), nil
@@ -256,56 +497,65 @@ func (p *Parser) parseSortSpecification(t []Token) *SortSpecification {
 	return s
 }
 
-func (p *Parser) parseSelectList(tokens TokenReader) (SelectList, error) {
-	res := make(SelectList, 0)
+// parseSelectList parses the select list which follows the SELECT token start. The list ends at FROM.
+func (p *Parser) parseSelectList(start Token, tokens TokenReader) (SelectList, error) {
+	list := p.collect(tokens, func(t Token) bool { return t.Type == FROM })
+	if len(list) == 0 {
+		return nil, p.unexpected(peekToken(tokens), IDENT, ASTERISK)
+	}
+	if next := peekToken(tokens); next.Type != FROM {
+		return nil, p.unexpected(next, FROM)
+	}
+	elements, err := p.splitElements(start, list)
+	if err != nil {
+		return nil, err
+	}
 
-	left := make(Tokens, 0)
-	for {
-		t, err := tokens.Peek(1)
+	res := make(SelectList, 0, len(elements))
+	for _, e := range elements {
+		s, err := p.parseSelectExpr(e)
 		if err != nil {
 			return nil, err
 		}
-		if err == io.EOF {
-			break
-		}
-		if t[0].Type == FROM {
-			e, err := p.parseSelectExpr(left)
-			if err != nil {
-				return nil, err
-			}
-			res = append(res, e)
-			return res, nil
-		}
-
-		tokens.Discard(1)
-		switch t[0].Type {
-		case COMMA:
-			e, err := p.parseSelectExpr(left)
-			if err != nil {
-				return nil, err
-			}
-
-			res = append(res, e)
-			left = make(Tokens, 0)
-		default:
-			left = append(left, t[0])
-		}
+		res = append(res, s)
 	}
 
 	return res, nil
 }
 
+// parseSelectExpr parses an element of a select list.
 func (p *Parser) parseSelectExpr(tokens Tokens) (SelectExpr, error) {
-	res := SelectExpr{}
-	if len(tokens) > 1 && tokens[1].Type == AS && tokens[2].Type == IDENT {
-		res.Alias = tokens[2].Value
+	res := SelectExpr{Span: tokensSpan(tokens)}
+	if n := len(tokens); n > 2 && tokens[n-2].Type == AS {
+		alias := tokens[n-1]
+		if alias.Type != IDENT && alias.Type != QUOTED_IDENT && alias.Type != STRING {
+			return res, p.unexpected(alias, IDENT, QUOTED_IDENT, STRING)
+		}
+		res.Alias = alias.Value
+		tokens = tokens[:n-2]
 	}
 
-	switch tokens[0].Type {
-	case IDENT:
+	switch n := len(tokens); {
+	case n == 1 && tokens[0].Type == ASTERISK:
+		res.Asterisk = true
+	case n == 1 && (tokens[0].Type == IDENT || tokens[0].Type == QUOTED_IDENT):
 		res.Column = tokens[0].Value
-	case ASTERISK:
+	case n > 2 && tokens[n-2].Type == PERIOD && tokens[n-1].Type == ASTERISK:
+		qualifier, err := p.parseValueExpr(tokens[:n-2])
+		if err != nil {
+			return res, err
+		}
+		if qualifier.Type != ValueTypeParameter {
+			return res, p.unexpected(tokens[0], IDENT, QUOTED_IDENT)
+		}
 		res.Asterisk = true
+		res.Qualifier = qualifier.Identifiers
+	default:
+		expr, err := p.parseExpr(tokens)
+		if err != nil {
+			return res, err
+		}
+		res.Expr = expr
 	}
 
 	return res, nil
@@ -346,91 +596,75 @@ func (p *Parser) parseTableExpression(tokens TokenReader) (TableExpression, erro
 }
 
 func (p *Parser) parseFromClause(tokens TokenReader) (FromClause, error) {
-	if t, err := tokens.Peek(1); err != nil || t[0].Type != FROM {
-		return FromClause{}, ErrInvalidQuery
+	if t, err := tokens.Peek(1); err != nil {
+		return FromClause{}, p.unexpected(Token{Type: EOF}, FROM)
+	} else if t[0].Type != FROM {
+		return FromClause{}, p.unexpected(t[0], FROM)
 	} else {
 		tokens.Discard(1)
 	}
 
-	tableList := make(TableList, 0)
-TableList:
-	for {
-		t, err := tokens.Peek(1)
-		if err != nil && err != io.EOF {
-			return FromClause{}, err
-		}
-		if err == io.EOF {
-			break
-		}
-
-		switch t[0].Type {
-		case WHERE, ORDERBY, GROUPBY, LEFT, RIGHT:
-			break TableList
-		}
+	return p.parseTableReferences(tokens)
+}
 
-		tokens.Discard(1)
-		switch t[0].Type {
-		case IDENT:
-			tableList = append(tableList, TableReference{Name: t[0].Value})
-		}
+// parseTableReferences parses the table list and the joined table which follow FROM or USING.
+func (p *Parser) parseTableReferences(tokens TokenReader) (FromClause, error) {
+	tableList, err := p.parseTableList(tokens)
+	if err != nil {
+		return FromClause{}, err
 	}
 
 	joinedTableList, err := p.parseJoinedTable(tokens)
 	if err != nil && err != io.EOF {
-		return FromClause{}, ErrInvalidQuery
+		return FromClause{}, err
 	}
 
 	return FromClause{Table: tableList, Join: joinedTableList}, nil
 }
 
-func (p *Parser) parseJoinedTable(tokens TokenReader) (JoinedTable, error) {
-	if t, err := tokens.Peek(1); err != nil {
-		return JoinedTable{}, err
-	} else {
-		switch t[0].Type {
-		case LEFT, RIGHT:
-		default:
-			return JoinedTable{}, err
-		}
-	}
-
-	joined := JoinedTable{}
-	left := make(Tokens, 0)
-JoinedTable:
+// parseJoinedTable parses the joined tables such as JOIN b ON a.id = b.id LEFT JOIN c ON b.id = c.id.
+func (p *Parser) parseJoinedTable(tokens TokenReader) ([]JoinedTable, error) {
+	var res []JoinedTable
 	for {
 		t, err := tokens.Peek(1)
 		if err != nil {
-			return JoinedTable{}, err
+			return res, err
 		}
-
 		switch t[0].Type {
-		case LEFT, RIGHT, OUTER:
-			left = append(left, t[0])
-			tokens.Discard(1)
-		case JOIN:
-			joined.Type = left
-			tokens.Discard(1)
-			left = make(Tokens, 0)
-		case ON:
-			if len(left) > 0 {
-				joined.Table = append(joined.Table, TableReference{Name: left[0].Value})
-			} else {
-				return JoinedTable{}, ErrInvalidQuery
-			}
-			tokens.Discard(1)
-			e, err := p.parseSearchCondition(tokens)
+		case LEFT, RIGHT, INNER, JOIN:
+		default:
+			return res, nil
+		}
+
+		joined := JoinedTable{Type: make(Tokens, 0)}
+		for {
+			t, err := p.expect(tokens, LEFT, RIGHT, INNER, OUTER, JOIN)
 			if err != nil {
-				return JoinedTable{}, err
+				return nil, err
 			}
-			joined.Cond = e
-			break JoinedTable
-		case IDENT:
-			left = append(left, t[0])
-			tokens.Discard(1)
+			if t.Type == JOIN {
+				break
+			}
+			joined.Type = append(joined.Type, t)
 		}
-	}
 
-	return joined, nil
+		table, err := p.parseTableReference(tokens)
+		if err != nil {
+			return nil, err
+		}
+		joined.Table = append(joined.Table, table)
+
+		if _, err := p.expect(tokens, ON); err != nil {
+			return nil, err
+		}
+		e, err := p.parseSearchCondition(tokens)
+		if err != nil {
+			return nil, err
+		}
+		joined.Cond = e
+
+		res = append(res, joined)
+	}
 }
 
 func (p *Parser) parseGroupByClause(tokens TokenReader) (GroupByClause, error) {
@@ -474,96 +708,103 @@ func (p *Parser) parseHavingClause(tokens TokenReader) (HavingClause, error) {
 
 	expr, err := p.parseSearchCondition(tokens)
 	if err != nil {
-		return HavingClause{}, ErrInvalidQuery
+		return HavingClause{}, err
 	}
 
 	return HavingClause{Cond: expr}, nil
 }
 
 func (p *Parser) parseWhereClause(tokens TokenReader) (WhereClause, error) {
-	if t, err := tokens.Peek(1); err != nil || t[0].Type != WHERE {
-		return WhereClause{}, ErrInvalidQuery
+	if t, err := tokens.Peek(1); err != nil {
+		return WhereClause{}, p.unexpected(Token{Type: EOF}, WHERE)
+	} else if t[0].Type != WHERE {
+		return WhereClause{}, p.unexpected(t[0], WHERE)
 	} else {
 		tokens.Discard(1)
 	}
 
 	expr, err := p.parseSearchCondition(tokens)
 	if err != nil {
-		return WhereClause{}, ErrInvalidQuery
+		return WhereClause{}, err
 	}
 
 	return WhereClause{Cond: expr}, nil
 }
 
+// parseSearchCondition parses the search condition which ends at a clause such as GROUP BY.
 func (p *Parser) parseSearchCondition(tokens TokenReader) (Expr, error) {
-	depth := 0
+	start := lastEnd(tokens)
+	return p.parseBooleanTokens(p.collect(tokens, isClauseStart), start)
+}
 
-	left := make(Tokens, 0)
-	for {
-		t, err := tokens.Peek(1)
-		if err != nil && err != io.EOF {
+// parseBooleanTokens parses a search condition. OR binds less tightly than AND and both are left associative,
+// so tokens are split at the last OR out of parentheses, or at the last AND when there is no such OR.
+// start is the position where tokens begin, at which an empty condition is reported.
+func (p *Parser) parseBooleanTokens(tokens Tokens, start Position) (Expr, error) {
+	if len(tokens) == 0 {
+		return nil, p.unexpected(Token{Type: EOF, Position: start, End: start}, valueTokenTypes...)
+	}
+
+	if i := booleanOperatorIndex(tokens); i >= 0 {
+		op := tokens[i]
+		l, err := p.parseBooleanTokens(tokens[:i], start)
+		if err != nil {
 			return nil, err
 		}
-		if err == io.EOF {
-			break
+		r, err := p.parseBooleanTokens(tokens[i+1:], op.End)
+		if err != nil {
+			return nil, err
+		}
+		b := &BooleanTerm{
+			Span:    Span{StartPos: l.Pos(), EndPos: r.End()},
+			Boolean: op,
+			Left:    l,
+			Right:   r,
 		}
+		return b, nil
+	}
 
-		switch t[0].Type {
+	if inner := removeRedundantParen(tokens); len(inner) != len(tokens) {
+		return p.parseBooleanTokens(inner, tokens[0].End)
+	}
+	return p.parseBooleanValueExpression(NewTokensReader(tokens))
+}
+
+// booleanOperatorIndex returns the index of the last OR out of parentheses, or the last AND when there is no such OR.
+// It returns -1 when tokens have neither.
+func booleanOperatorIndex(tokens Tokens) int {
+	or, and := -1, -1
+	depth := 0
+	for i, t := range tokens {
+		switch t.Type {
 		case LPAREN:
 			depth++
-			if depth < 0 {
-				tokens.Discard(1)
-				continue
-			}
 		case RPAREN:
 			depth--
-			if depth < 0 {
-				tokens.Discard(1)
-				continue
+		case OR:
+			if depth == 0 {
+				or = i
 			}
-		case AND, OR:
-			if depth != 0 {
-				break
+		case AND:
+			if depth == 0 {
+				and = i
 			}
-
-			l, err := p.parseSearchCondition(NewTokensReader(removeRedundantParen(left)))
-			tokens.Discard(1)
-
-			least := make(Tokens, 0)
-		Skip:
-			for {
-				t, err := tokens.Peek(1)
-				if err == io.EOF {
-					break
-				}
-
-				switch t[0].Type {
-				case ORDERBY, GROUPBY:
-					break Skip
-				}
-				tokens.Discard(1)
-				least = append(least, t[0])
-			}
-
-			r, err := p.parseSearchCondition(NewTokensReader(removeRedundantParen(least)))
-			_ = err
-			b := &BooleanTerm{
-				Boolean: t[0],
-				Left:    l,
-				Right:   r,
-			}
-			return b, nil
 		}
-
-		left = append(left, t[0])
-		tokens.Discard(1)
 	}
 
-	return p.parseBooleanValueExpression(NewTokensReader(left))
+	if or >= 0 {
+		return or
+	}
+	return and
 }
 
+// parseBooleanValueExpression parses a comparison. The tokens without a comparison operator are a value expression.
 func (p *Parser) parseBooleanValueExpression(tokens TokenReader) (Expr, error) {
+	var op *Token
 	left := make(Tokens, 0)
+	right := make(Tokens, 0)
+	// The tokens of a search condition are collected from p.reader, so the input ends after the last token read.
+	end := Token{Type: EOF, Position: p.reader.last.End, End: p.reader.last.End}
 	for {
 		t, err := tokens.Peek(1)
 		if err != nil && err != io.EOF {
@@ -572,86 +813,697 @@ func (p *Parser) parseBooleanValueExpression(tokens TokenReader) (Expr, error) {
 		if err == io.EOF {
 			break
 		}
+		if t[0].Type == EOF {
+			end = t[0]
+			tokens.Discard(1)
+			break
+		}
 
+		tokens.Discard(1)
 		switch t[0].Type {
-		case EQUAL, LSS, GTR:
-			l, err := p.parseBooleanValueExpression(NewTokensReader(left))
-			if err != nil {
-				return &ComparisonExpr{}, err
-			}
-			tokens.Discard(1)
-			r, err := p.parseBooleanValueExpression(tokens)
-			if err != nil {
-				return &ComparisonExpr{}, err
-			}
-			o := ComparisonOperator(ComparisonOperatorEqual)
-			switch t[0].Type {
-			case LSS:
-				o = ComparisonOperatorLessThan
-			case GTR:
-				o = ComparisonOperatorGreaterThan
+		case EQUAL, LSS, GTR, LEQ, GEQ, NEQ, NULLSAFEEQUAL:
+			if op != nil {
+				return nil, p.unexpected(t[0], valueTokenTypes...)
 			}
+			op = &t[0]
+			continue
+		}
 
-			v := &ComparisonExpr{
-				Operator:   o,
-				LeftValue:  p.parseValueExpr(l.(Tokens)),
-				RightValue: p.parseValueExpr(r.(Tokens)),
-			}
-			return v, nil
-		case EOF:
-			tokens.Discard(1)
-			return left, nil
+		if op == nil {
+			left = append(left, t[0])
+		} else {
+			right = append(right, t[0])
+		}
+	}
+
+	if op == nil {
+		if len(left) == 0 {
+			return nil, p.unexpected(end, valueTokenTypes...)
 		}
+		if left[len(left)-1].Type == NULL {
+			return p.parseNullPredicate(left)
+		}
+		return p.parseValueExpr(left)
+	}
 
-		left = append(left, t[0])
-		tokens.Discard(1)
+	o := ComparisonOperator(ComparisonOperatorEqual)
+	switch op.Type {
+	case LSS:
+		o = ComparisonOperatorLessThan
+	case GTR:
+		o = ComparisonOperatorGreaterThan
+	case LEQ:
+		o = ComparisonOperatorLessThanOrEqual
+	case GEQ:
+		o = ComparisonOperatorGreaterThanOrEqual
+	case NEQ:
+		o = ComparisonOperatorNotEqual
+	case NULLSAFEEQUAL:
+		o = ComparisonOperatorNullSafeEqual
+	}
+
+	lv, err := p.parseOperand(*op, left)
+	if err != nil {
+		return nil, err
+	}
+	rv, err := p.parseOperand(*op, right)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &ComparisonExpr{
+		Span:       Span{StartPos: lv.Pos(), EndPos: rv.End()},
+		Operator:   o,
+		LeftValue:  lv,
+		RightValue: rv,
+	}
+	return v, nil
+}
+
+// parseNullPredicate parses tokens which end with IS NULL or IS NOT NULL. The tokens of NULL alone are a value expression.
+func (p *Parser) parseNullPredicate(tokens Tokens) (Expr, error) {
+	null := tokens[len(tokens)-1]
+	res := &NullPredicate{Span: tokensSpan(tokens)}
+	tokens = tokens[:len(tokens)-1]
+	if len(tokens) == 0 {
+		return p.parseValueExpr(Tokens{null})
+	}
+
+	if tokens[len(tokens)-1].Type == NOT {
+		res.Not = true
+		tokens = tokens[:len(tokens)-1]
+	}
+	if len(tokens) == 0 || !isKeyword(tokens[len(tokens)-1], "is") {
+		return nil, p.unexpected(null)
+	}
+	is := tokens[len(tokens)-1]
+	if len(tokens) == 1 {
+		return nil, p.newError(is, "missing operand of IS", valueTokenTypes)
+	}
+	v, err := p.parseValueExpr(tokens[:len(tokens)-1])
+	if err != nil {
+		return nil, err
+	}
+	res.Value = v
+
+	return res, nil
+}
+
+// parseOperand parses the operand of the operator op.
+func (p *Parser) parseOperand(op Token, tokens Tokens) (ValueExpr, error) {
+	if len(tokens) == 0 || tokens[0].Type == EOF {
+		return ValueExpr{}, p.newError(op, "missing operand of "+op.Type.String(), valueTokenTypes)
 	}
 
-	return left, nil
+	return p.parseValueExpr(tokens)
 }
 
-func (p *Parser) parseValueExpr(tokens Tokens) ValueExpr {
+func (p *Parser) parseValueExpr(tokens Tokens) (ValueExpr, error) {
+	span := tokensSpan(tokens)
 	if len(tokens) == 1 {
 		switch tokens[0].Type {
-		case IDENT:
-			return ValueExpr{Type: ValueTypeString, StringValue: tokens[0].Value}
+		case IDENT, QUOTED_IDENT:
+			return ValueExpr{Span: span, Type: ValueTypeParameter, Identifiers: []string{tokens[0].Value}}, nil
+		case STRING:
+			return ValueExpr{Span: span, Type: ValueTypeString, StringValue: tokens[0].Value}, nil
 		case INT:
-			return ValueExpr{Type: ValueTypeInt, IntValue: tokens[0].IntValue}
+			return ValueExpr{Span: span, Type: ValueTypeInt, IntValue: tokens[0].IntValue, BigIntValue: tokens[0].BigIntValue}, nil
+		case DECIMAL:
+			return ValueExpr{Span: span, Type: ValueTypeDecimal, FloatValue: tokens[0].FloatValue, BigFloatValue: tokens[0].BigFloatValue}, nil
+		case FLOAT:
+			return ValueExpr{Span: span, Type: ValueTypeFloat, FloatValue: tokens[0].FloatValue, BigFloatValue: tokens[0].BigFloatValue}, nil
 		case QUESTION:
-			return ValueExpr{Type: ValueTypeDynamicParameter}
+			p.placeholders++
+			return ValueExpr{Span: span, Type: ValueTypeDynamicParameter, ParameterIndex: p.placeholders}, nil
+		case NULL:
+			return ValueExpr{Span: span, Type: ValueTypeNull}, nil
+		case DEFAULT:
+			return ValueExpr{Span: span, Type: ValueTypeDefault}, nil
+		case PARAM:
+			if tokens[0].Value == "" {
+				return ValueExpr{Span: span, Type: ValueTypeDynamicParameter, ParameterIndex: tokens[0].IntValue}, nil
+			}
+			return ValueExpr{Span: span, Type: ValueTypeNamedParameter, ParameterName: tokens[0].Value}, nil
 		}
-	} else {
+	} else if len(tokens) == 2 && (tokens[0].Type == MINUS || tokens[0].Type == PLUS) {
+		switch tokens[1].Type {
+		case INT, DECIMAL, FLOAT:
+			v, err := p.parseValueExpr(tokens[1:])
+			if err == nil && tokens[0].Type == MINUS {
+				v = negateValueExpr(v)
+			}
+			v.Span = span
+			return v, err
+		}
+	} else if len(tokens) > 1 {
 		if tokens[1].Type == PERIOD {
-			identifies := make([]string, 0, len(tokens)+1)
-			for i := 0; i < len(tokens); i += 2 {
-				identifies = append(identifies, tokens[i].Value)
+			// <identifier chain> ::= <identifier> [ { <period> <identifier> }... ]
+			identifies := make([]string, 0, len(tokens)/2+1)
+			for i, t := range tokens {
+				if i%2 == 1 {
+					if t.Type != PERIOD {
+						return ValueExpr{}, p.unexpected(t, PERIOD)
+					}
+					continue
+				}
+				if t.Type != IDENT && t.Type != QUOTED_IDENT {
+					return ValueExpr{}, p.unexpected(t, IDENT, QUOTED_IDENT)
+				}
+				identifies = append(identifies, t.Value)
+			}
+			if last := tokens[len(tokens)-1]; last.Type == PERIOD {
+				return ValueExpr{}, p.newError(last, "missing identifier after PERIOD", []TokenType{IDENT, QUOTED_IDENT})
 			}
-			return ValueExpr{Type: ValueTypeParameter, Identifiers: identifies}
+			return ValueExpr{Span: span, Type: ValueTypeParameter, Identifiers: identifies}, nil
 		}
 	}
-	return ValueExpr{}
+	return ValueExpr{}, p.unexpected(tokens[0], valueTokenTypes...)
 }
 
-func (p *Parser) parserPredicate(token Token) Expr {
-	return &RawValue{Token: token}
+func negateValueExpr(v ValueExpr) ValueExpr {
+	switch v.Type {
+	case ValueTypeInt:
+		if v.BigIntValue == nil {
+			v.IntValue = -v.IntValue
+			break
+		}
+		// -9223372036854775808 overflows as a literal but fits in int after negation.
+		i := new(big.Int).Neg(v.BigIntValue)
+		if i.IsInt64() && int64(int(i.Int64())) == i.Int64() {
+			v.IntValue = int(i.Int64())
+			v.BigIntValue = nil
+		} else {
+			v.BigIntValue = i
+		}
+	case ValueTypeDecimal, ValueTypeFloat:
+		v.FloatValue = -v.FloatValue
+		if v.BigFloatValue != nil {
+			v.BigFloatValue = new(big.Float).Neg(v.BigFloatValue)
+		}
+	}
+
+	return v
 }
 
-func (p *Parser) parseBooleanTerm(tokens TokenReader) (Expr, error) {
-	return BooleanTerm{}, nil
+// tokensSpan returns the span from the first token to the last token.
+func tokensSpan(tokens Tokens) Span {
+	if len(tokens) == 0 {
+		return Span{}
+	}
+	return Span{StartPos: tokens[0].Position, EndPos: tokens[len(tokens)-1].End}
 }
 
+// removeRedundantParen removes the parentheses which enclose the whole tokens such as (a = 1).
+// The tokens such as (a) = (b) are returned as they are.
 func removeRedundantParen(tokens Tokens) Tokens {
-	if len(tokens) < 3 {
+	if len(tokens) < 3 || tokens[0].Type != LPAREN || tokens[len(tokens)-1].Type != RPAREN {
 		return tokens
 	}
 
-	if tokens[0].Type == LPAREN && tokens[len(tokens)-1].Type == RPAREN {
-		return tokens[1 : len(tokens)-1]
+	depth := 0
+	for _, t := range tokens[:len(tokens)-1] {
+		switch t.Type {
+		case LPAREN:
+			depth++
+		case RPAREN:
+			depth--
+		}
+		if depth == 0 {
+			return tokens
+		}
 	}
 
-	return tokens
+	return tokens[1 : len(tokens)-1]
 }
 
 func NewSelect() *Select {
 	return &Select{}
 }
+
+// expectEnd reports an error when tokens remain after a statement.
+func (p *Parser) expectEnd(tokens TokenReader) error {
+	t, err := tokens.Peek(1)
+	if err != nil || t[0].Type == EOF {
+		return nil
+	}
+
+	return p.unexpected(t[0], EOF)
+}
+
+// peekToken returns the next token without consuming it. It returns an EOF token at the end of the input.
+func peekToken(tokens TokenReader) Token {
+	t, err := tokens.Peek(1)
+	if err != nil {
+		return Token{Type: EOF}
+	}
+	return t[0]
+}
+
+// peekTokens returns up to n tokens which follow without consuming them. It returns an EOF token at least.
+func peekTokens(tokens TokenReader, n int) Tokens {
+	for ; n > 0; n-- {
+		if t, err := tokens.Peek(n); err == nil {
+			return t
+		}
+	}
+	return Tokens{{Type: EOF}}
+}
+
+// isClauseStart reports whether t begins a clause which follows a search condition.
+func isClauseStart(t Token) bool {
+	switch t.Type {
+	case WHERE, GROUPBY, HAVING, ORDERBY, LIMIT, SET, ON, ONDUPLICATEKEYUPDATE, JOIN, LEFT, RIGHT, INNER:
+		return true
+	}
+	return isKeyword(t, "returning")
+}
+
+// isKeyword reports whether t is the unreserved keyword word.
+// Unreserved keywords such as VALUES are scanned as IDENT so that they can be used as names.
+func isKeyword(t Token, word string) bool {
+	return t.Type == IDENT && strings.EqualFold(t.Value, word)
+}
+
+// expect consumes the next token if it is one of types.
+func (p *Parser) expect(tokens TokenReader, types ...TokenType) (Token, error) {
+	t, err := tokens.Peek(1)
+	if err != nil {
+		return Token{}, p.unexpected(Token{Type: EOF}, types...)
+	}
+	for _, typ := range types {
+		if t[0].Type == typ {
+			tokens.Discard(1)
+			return t[0], nil
+		}
+	}
+
+	return Token{}, p.unexpected(t[0], types...)
+}
+
+// expectKeyword consumes the next token if it is the unreserved keyword word.
+func (p *Parser) expectKeyword(tokens TokenReader, word string) (Token, error) {
+	t, err := tokens.Peek(1)
+	if err != nil {
+		return Token{}, p.newError(Token{Type: EOF}, "unexpected end of input, expected "+strings.ToUpper(word), nil)
+	}
+	if !isKeyword(t[0], word) {
+		return Token{}, p.newError(t[0], "unexpected "+describeToken(t[0])+", expected "+strings.ToUpper(word), nil)
+	}
+	tokens.Discard(1)
+
+	return t[0], nil
+}
+
+// collect reads tokens until stop reports true for a token out of parentheses or the input ends.
+// The token which stops collecting and the EOF token are not consumed.
+func (p *Parser) collect(tokens TokenReader, stop func(Token) bool) Tokens {
+	res := make(Tokens, 0)
+	depth := 0
+	for {
+		t, err := tokens.Peek(1)
+		if err != nil || t[0].Type == EOF {
+			return res
+		}
+		if depth == 0 && stop(t[0]) {
+			return res
+		}
+
+		switch t[0].Type {
+		case LPAREN:
+			depth++
+		case RPAREN:
+			depth--
+		}
+		if depth < 0 {
+			return res
+		}
+		tokens.Discard(1)
+		res = append(res, t[0])
+	}
+}
+
+// splitComma splits tokens by commas out of parentheses.
+func splitComma(tokens Tokens) []Tokens {
+	res := make([]Tokens, 0)
+	left := make(Tokens, 0)
+	depth := 0
+	for _, t := range tokens {
+		switch t.Type {
+		case LPAREN:
+			depth++
+		case RPAREN:
+			depth--
+		case COMMA:
+			if depth == 0 {
+				res = append(res, left)
+				left = make(Tokens, 0)
+				continue
+			}
+		}
+		left = append(left, t)
+	}
+
+	return append(res, left)
+}
+
+// parseParenList parses a list enclosed by parentheses and returns the elements separated by commas.
+func (p *Parser) parseParenList(tokens TokenReader) ([]Tokens, error) {
+	res, _, _, err := p.parseParenTokens(tokens)
+	return res, err
+}
+
+// parseParenElements parses a list enclosed by parentheses and returns a reader for each element.
+// Each reader ends with an EOF token at the comma or the right parenthesis which follows the element.
+func (p *Parser) parseParenElements(tokens TokenReader) ([]TokenReader, error) {
+	elements, list, rparen, err := p.parseParenTokens(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	return elementReaders(list, elements, rparen), nil
+}
+
+// parseParenTokens parses a list enclosed by parentheses and returns the elements, the tokens inside the parentheses
+// and the right parenthesis.
+func (p *Parser) parseParenTokens(tokens TokenReader) ([]Tokens, Tokens, Token, error) {
+	lparen, err := p.expect(tokens, LPAREN)
+	if err != nil {
+		return nil, nil, Token{}, err
+	}
+	list := p.collect(tokens, func(Token) bool { return false })
+	rparen, err := p.expect(tokens, RPAREN)
+	if err != nil {
+		return nil, nil, Token{}, err
+	}
+
+	res, err := p.splitElements(lparen, list)
+	if err != nil {
+		return nil, nil, Token{}, err
+	}
+
+	return res, list, rparen, nil
+}
+
+// splitElements splits list by commas and reports an empty element.
+// The error of an empty first element is reported at first which is the token before list.
+func (p *Parser) splitElements(first Token, list Tokens) ([]Tokens, error) {
+	res := splitComma(list)
+	for i, e := range res {
+		if len(e) == 0 {
+			at := first
+			if i > 0 {
+				at = res[i-1][len(res[i-1])-1]
+			}
+			return nil, p.newError(at, "empty element in the list", nil)
+		}
+	}
+
+	return res, nil
+}
+
+// elementReaders returns a reader for each element which splitComma returns for list.
+// Each reader ends with an EOF token at the comma which follows the element, or at end for the last element.
+func elementReaders(list Tokens, elements []Tokens, end Token) []TokenReader {
+	res := make([]TokenReader, 0, len(elements))
+	i := 0
+	for _, e := range elements {
+		i += len(e)
+		next := end
+		if i < len(list) {
+			next = list[i]
+		}
+		i++
+		eof := Token{Type: EOF, Position: next.Position, End: next.Position}
+		res = append(res, &positionReader{TokenReader: NewTokensReader(append(e[:len(e):len(e)], eof))})
+	}
+
+	return res
+}
+
+// parseIdentifierList parses a list of names such as (id, name).
+func (p *Parser) parseIdentifierList(tokens TokenReader) ([]string, error) {
+	list, err := p.parseParenList(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(list))
+	for _, e := range list {
+		if e[0].Type != IDENT && e[0].Type != QUOTED_IDENT {
+			return nil, p.unexpected(e[0], IDENT, QUOTED_IDENT)
+		}
+		if len(e) > 1 {
+			return nil, p.unexpected(e[1], COMMA, RPAREN)
+		}
+		res = append(res, e[0].Value)
+	}
+
+	return res, nil
+}
+
+// parseTableName parses a table name which may be qualified by the schema such as public.users.
+func (p *Parser) parseTableName(tokens TokenReader) (TableReference, error) {
+	t, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+	if err != nil {
+		return TableReference{}, err
+	}
+	res := TableReference{Span: tokensSpan(Tokens{t}), Name: t.Value}
+
+	for {
+		next, err := tokens.Peek(1)
+		if err != nil || next[0].Type != PERIOD {
+			return res, nil
+		}
+		tokens.Discard(1)
+		t, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+		if err != nil {
+			return TableReference{}, err
+		}
+		res.Name += "." + t.Value
+		res.EndPos = t.End
+	}
+}
+
+// parseTableReference parses a table name followed by an optional alias such as users AS u.
+func (p *Parser) parseTableReference(tokens TokenReader) (TableReference, error) {
+	res, err := p.parseTableName(tokens)
+	if err != nil {
+		return TableReference{}, err
+	}
+
+	t, err := tokens.Peek(1)
+	if err != nil {
+		return res, nil
+	}
+	if t[0].Type == AS {
+		tokens.Discard(1)
+		alias, err := p.expect(tokens, IDENT, QUOTED_IDENT)
+		if err != nil {
+			return TableReference{}, err
+		}
+		res.Alias = alias.Value
+		res.EndPos = alias.End
+	} else if isAlias(t[0]) {
+		tokens.Discard(1)
+		res.Alias = t[0].Value
+		res.EndPos = t[0].End
+	}
+
+	return res, nil
+}
+
+// parseTableList parses table references separated by commas.
+func (p *Parser) parseTableList(tokens TokenReader) (TableList, error) {
+	res := make(TableList, 0)
+	for {
+		table, err := p.parseTableReference(tokens)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, table)
+
+		if t, err := tokens.Peek(1); err != nil || t[0].Type != COMMA {
+			return res, nil
+		}
+		tokens.Discard(1)
+	}
+}
+
+// unreservedKeywords is the unreserved keywords which follow a table name, so they are not an alias without AS.
+var unreservedKeywords = []string{"values", "using", "returning"}
+
+// isAlias reports whether t is an alias which is written without AS.
+func isAlias(t Token) bool {
+	if t.Type == QUOTED_IDENT {
+		return true
+	}
+	if t.Type != IDENT {
+		return false
+	}
+	for _, k := range unreservedKeywords {
+		if isKeyword(t, k) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseAssignments parses col = expr separated by commas until stop reports true.
+func (p *Parser) parseAssignments(tokens TokenReader, stop func(Token) bool) ([]*Assignment, error) {
+	list := p.collect(tokens, stop)
+	if len(list) == 0 {
+		return nil, p.unexpected(peekToken(tokens), IDENT, QUOTED_IDENT)
+	}
+
+	res := make([]*Assignment, 0)
+	elements := splitComma(list)
+	for j, e := range elements {
+		if len(e) == 0 {
+			at := list[0]
+			if j > 0 {
+				at = elements[j-1][len(elements[j-1])-1]
+			}
+			return nil, p.newError(at, "empty element in the list", nil)
+		}
+		i := 0
+		for i < len(e) && e[i].Type != EQUAL {
+			i++
+		}
+		if i == len(e) {
+			return nil, p.newError(e[len(e)-1], "missing = in the assignment", []TokenType{EQUAL})
+		}
+		if i == 0 {
+			return nil, p.unexpected(e[0], IDENT, QUOTED_IDENT)
+		}
+		column, err := p.parseValueExpr(e[:i])
+		if err != nil {
+			return nil, err
+		}
+		if column.Type != ValueTypeParameter {
+			return nil, p.unexpected(e[0], IDENT, QUOTED_IDENT)
+		}
+		if i+1 == len(e) {
+			return nil, p.newError(e[i], "missing operand of EQUAL", valueTokenTypes)
+		}
+		v, err := p.parseExpr(e[i+1:])
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &Assignment{Span: tokensSpan(e), Column: column.Identifiers, Value: v})
+	}
+
+	return res, nil
+}
+
+// parseExpr parses an expression which is an arithmetic expression, a function call or a value expression.
+// <numeric value expression> ::= <term> | <numeric value expression> { <plus sign> | <minus sign> } <term>
+// <term> ::= <factor> | <term> { <asterisk> | <solidus> | <percent> } <factor>
+// <factor> ::= [ <sign> ] <numeric primary>
+func (p *Parser) parseExpr(tokens Tokens) (Expr, error) {
+	if len(tokens) == 0 {
+		return nil, p.unexpected(Token{Type: EOF}, valueTokenTypes...)
+	}
+	if i := arithmeticOperatorIndex(tokens); i >= 0 {
+		return p.parseArithmeticExpr(tokens, i)
+	}
+	if inner := removeRedundantParen(tokens); len(inner) < len(tokens) {
+		return p.parseExpr(inner)
+	}
+
+	if isFuncCall(tokens) {
+		f := &FuncCall{Span: tokensSpan(tokens), Name: tokens[0].Value, Args: make([]Expr, 0)}
+		if len(tokens) == 3 {
+			return f, nil
+		}
+		for _, arg := range splitComma(tokens[2 : len(tokens)-1]) {
+			if len(arg) == 0 {
+				return nil, p.newError(tokens[1], "empty argument of "+tokens[0].Value, valueTokenTypes)
+			}
+			if len(arg) == 1 && arg[0].Type == ASTERISK {
+				f.Args = append(f.Args, &RawValue{Span: tokensSpan(arg), Token: arg[0]})
+				continue
+			}
+			e, err := p.parseExpr(arg)
+			if err != nil {
+				return nil, err
+			}
+			f.Args = append(f.Args, e)
+		}
+		return f, nil
+	}
+
+	return p.parseValueExpr(tokens)
+}
+
+// isFuncCall reports whether tokens are a name followed by the arguments enclosed by a pair of parentheses.
+func isFuncCall(tokens Tokens) bool {
+	if len(tokens) < 3 || tokens[0].Type != IDENT || tokens[1].Type != LPAREN || tokens[len(tokens)-1].Type != RPAREN {
+		return false
+	}
+
+	return len(tokens) == 3 || len(removeRedundantParen(tokens[1:])) == len(tokens)-3
+}
+
+// arithmeticOperatorIndex returns the index of the binary operator out of parentheses which is evaluated last,
+// that is the rightmost one of the lowest precedence. || has lower precedence than + and - as in PostgreSQL.
+// It returns -1 when tokens have no binary operator.
+func arithmeticOperatorIndex(tokens Tokens) int {
+	concat, additive, multiplicative := -1, -1, -1
+	depth := 0
+	for i, t := range tokens {
+		switch t.Type {
+		case LPAREN:
+			depth++
+		case RPAREN:
+			depth--
+		}
+		// An operator which follows another operator or begins the tokens is a sign.
+		if depth != 0 || i == 0 || !isOperandEnd(tokens[i-1]) {
+			continue
+		}
+		switch t.Type {
+		case CONCAT:
+			concat = i
+		case PLUS, MINUS:
+			additive = i
+		case ASTERISK, SLASH, PERCENT:
+			multiplicative = i
+		}
+	}
+
+	if concat >= 0 {
+		return concat
+	}
+	if additive >= 0 {
+		return additive
+	}
+	return multiplicative
+}
+
+// isOperandEnd reports whether t can be the last token of an operand.
+func isOperandEnd(t Token) bool {
+	switch t.Type {
+	case IDENT, QUOTED_IDENT, STRING, INT, DECIMAL, FLOAT, QUESTION, PARAM, NULL, RPAREN:
+		return true
+	}
+	return false
+}
+
+func (p *Parser) parseArithmeticExpr(tokens Tokens, i int) (Expr, error) {
+	op := tokens[i]
+	if i == len(tokens)-1 {
+		return nil, p.newError(op, "missing operand of "+op.Type.String(), valueTokenTypes)
+	}
+
+	left, err := p.parseExpr(tokens[:i])
+	if err != nil {
+		return nil, err
+	}
+	right, err := p.parseExpr(tokens[i+1:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArithmeticExpr{Span: tokensSpan(tokens), Operator: op, Left: left, Right: right}, nil
+}
@@ -1,8 +1,10 @@
 package parser
 
 import (
+	"errors"
 	"io"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -21,6 +23,12 @@ func assertSelectList(t *testing.T, expected SelectList, actual SelectList, i in
 		if c.Alias != "" && c.Alias != actual[k].Alias {
 			t.Fatalf("tokens %d: Expected alias %s, but got %s", i, c.Alias, actual[k].Alias)
 		}
+		if !reflect.DeepEqual(c.Qualifier, actual[k].Qualifier) {
+			t.Fatalf("tokens %d: Expected qualifier %v, but got %v", i, c.Qualifier, actual[k].Qualifier)
+		}
+		if c.Expr != nil || actual[k].Expr != nil {
+			assertExpr(t, c.Expr, actual[k].Expr, i)
+		}
 	}
 }
 
@@ -36,13 +44,16 @@ func assertFromClause(t *testing.T, expected FromClause, actual FromClause, i in
 	assertJoinedTable(t, expected.Join, actual.Join, i)
 }
 
-func assertJoinedTable(t *testing.T, expected JoinedTable, actual JoinedTable, i int) {
-	if len(expected.Table) != len(actual.Table) {
-		t.Fatalf("tokens %d: Expected length %d, but actual %d", i, len(expected.Table), len(actual.Table))
+func assertJoinedTable(t *testing.T, expected []JoinedTable, actual []JoinedTable, i int) {
+	if len(expected) != len(actual) {
+		t.Fatalf("tokens %d: Expected %d joined tables, but actual %d", i, len(expected), len(actual))
 	}
 
-	assertTokens(t, expected.Type, actual.Type)
-	assertExpr(t, expected.Cond, actual.Cond, i)
+	for k, e := range expected {
+		assertTableList(t, e.Table, actual[k].Table, i)
+		assertTokens(t, e.Type, actual[k].Type)
+		assertExpr(t, e.Cond, actual[k].Cond, i)
+	}
 }
 
 func assertGroupByClause(t *testing.T, expected GroupByClause, actual GroupByClause, i int) {
@@ -98,9 +109,248 @@ func assertExpr(t *testing.T, expected Expr, actual Expr, i int) {
 	if v, ok := expected.(*BooleanTerm); ok {
 		assertBooleanTerm(t, v, actual.(*BooleanTerm))
 	}
+	if v, ok := expected.(ValueExpr); ok {
+		assertValueExpr(t, v, actual.(ValueExpr))
+	}
+	if v, ok := expected.(*NullPredicate); ok {
+		a := actual.(*NullPredicate)
+		if v.Not != a.Not {
+			t.Fatalf("tokens %d: Expected Not %v but got %v", i, v.Not, a.Not)
+		}
+		assertValueExpr(t, v.Value, a.Value)
+	}
+	if v, ok := expected.(*FuncCall); ok {
+		a := actual.(*FuncCall)
+		if v.Name != a.Name || len(v.Args) != len(a.Args) {
+			t.Fatalf("tokens %d: Expected %s with %d args but got %s with %d args", i, v.Name, len(v.Args), a.Name, len(a.Args))
+		}
+		for j := range v.Args {
+			assertExpr(t, v.Args[j], a.Args[j], i)
+		}
+	}
+	if v, ok := expected.(*RawValue); ok && v.Token.Type != actual.(*RawValue).Token.Type {
+		t.Fatalf("tokens %d: Expected %v but got %v", i, v.Token.Type, actual.(*RawValue).Token.Type)
+	}
+	if v, ok := expected.(*ArithmeticExpr); ok {
+		a := actual.(*ArithmeticExpr)
+		if v.Operator.Type != a.Operator.Type {
+			t.Fatalf("tokens %d: Expected operator %v but got %v", i, v.Operator.Type, a.Operator.Type)
+		}
+		assertExpr(t, v.Left, a.Left, i)
+		assertExpr(t, v.Right, a.Right, i)
+	}
+}
+
+func assertAssignments(t *testing.T, expected []*Assignment, actual []*Assignment, i int) {
+	if len(expected) != len(actual) {
+		t.Fatalf("tokens %d: Expected %d assignments but got %d", i, len(expected), len(actual))
+	}
+	for j, e := range expected {
+		if !reflect.DeepEqual(e.Column, actual[j].Column) {
+			t.Fatalf("tokens %d: Expected column %v but got %v", i, e.Column, actual[j].Column)
+		}
+		assertExpr(t, e.Value, actual[j].Value, i)
+	}
+}
+
+func assertSelect(t *testing.T, expected *Select, actual *Select, i int) {
+	if (expected == nil) != (actual == nil) {
+		t.Fatalf("tokens %d: Expected %v but got %v", i, expected, actual)
+	}
+	if expected == nil {
+		return
+	}
+	assertSelectList(t, expected.SelectList, actual.SelectList, i)
+	assertFromClause(t, expected.Table.From, actual.Table.From, i)
+	assertWhereClause(t, expected.Table.Where, actual.Table.Where, i)
+	assertGroupByClause(t, expected.Table.GroupBy, actual.Table.GroupBy, i)
+	assertHavingClause(t, expected.Table.Having, actual.Table.Having, i)
+	assertOrderByClause(t, expected.OrderBy, actual.OrderBy, i)
+	if expected.Limit != nil || actual.Limit != nil {
+		assertExpr(t, expected.Limit, actual.Limit, i)
+	}
+}
+
+func assertTableList(t *testing.T, expected TableList, actual TableList, i int) {
+	if len(expected) != len(actual) {
+		t.Fatalf("tokens %d: Expected %d tables but got %d", i, len(expected), len(actual))
+	}
+	for j, e := range expected {
+		assertTableReference(t, e, actual[j], i)
+	}
+}
+
+func assertUpdate(t *testing.T, expected *Update, actual *Update, i int) {
+	assertTableReference(t, expected.Table, actual.Table, i)
+	assertTableList(t, expected.Tables, actual.Tables, i)
+	assertJoinedTable(t, expected.Join, actual.Join, i)
+	assertAssignments(t, expected.Set, actual.Set, i)
+	assertFromClause(t, expected.From, actual.From, i)
+	assertWhereClause(t, expected.Where, actual.Where, i)
+	assertOrderByClause(t, expected.OrderBy, actual.OrderBy, i)
+	assertExpr(t, expected.Limit, actual.Limit, i)
+}
+
+func assertDelete(t *testing.T, expected *Delete, actual *Delete, i int) {
+	assertTableList(t, expected.Targets, actual.Targets, i)
+	assertFromClause(t, expected.From, actual.From, i)
+	assertFromClause(t, expected.Using, actual.Using, i)
+	assertWhereClause(t, expected.Where, actual.Where, i)
+	assertOrderByClause(t, expected.OrderBy, actual.OrderBy, i)
+	assertExpr(t, expected.Limit, actual.Limit, i)
+	assertSelectList(t, expected.Returning, actual.Returning, i)
+}
+
+func assertCreateTable(t *testing.T, expected *CreateTable, actual *CreateTable, i int) {
+	assertTableReference(t, expected.Table, actual.Table, i)
+	if expected.IfNotExists != actual.IfNotExists {
+		t.Fatalf("tokens %d: Expected IF NOT EXISTS %v but got %v", i, expected.IfNotExists, actual.IfNotExists)
+	}
+	if len(expected.Columns) != len(actual.Columns) {
+		t.Fatalf("tokens %d: Expected %d columns but got %d", i, len(expected.Columns), len(actual.Columns))
+	}
+	for j, e := range expected.Columns {
+		a := actual.Columns[j]
+		if e.Name != a.Name || e.Type.Name != a.Type.Name || !reflect.DeepEqual(e.Type.Args, a.Type.Args) || !reflect.DeepEqual(e.Type.Values, a.Type.Values) || e.Type.Unsigned != a.Type.Unsigned {
+			t.Fatalf("tokens %d: Expected column %s %+v but got %s %+v", i, e.Name, e.Type, a.Name, a.Type)
+		}
+		if e.NotNull != a.NotNull || e.PrimaryKey != a.PrimaryKey || e.Unique != a.Unique || e.AutoIncrement != a.AutoIncrement {
+			t.Fatalf("tokens %d: Expected column %+v but got %+v", i, e, a)
+		}
+		if e.Default != nil || a.Default != nil {
+			assertExpr(t, e.Default, a.Default, i)
+		}
+		if e.Check != nil || a.Check != nil {
+			assertExpr(t, e.Check, a.Check, i)
+		}
+		assertReferences(t, e.References, a.References, i)
+	}
+	if len(expected.Constraints) != len(actual.Constraints) {
+		t.Fatalf("tokens %d: Expected %d constraints but got %d", i, len(expected.Constraints), len(actual.Constraints))
+	}
+	for j, e := range expected.Constraints {
+		a := actual.Constraints[j]
+		if e.Name != a.Name || e.Type != a.Type || !reflect.DeepEqual(e.Columns, a.Columns) {
+			t.Fatalf("tokens %d: Expected constraint %+v but got %+v", i, e, a)
+		}
+		if e.Check != nil || a.Check != nil {
+			assertExpr(t, e.Check, a.Check, i)
+		}
+		assertReferences(t, e.References, a.References, i)
+	}
+}
+
+func assertAlterTable(t *testing.T, expected *AlterTable, actual *AlterTable, i int) {
+	assertTableReference(t, expected.Table, actual.Table, i)
+	if len(expected.Actions) != len(actual.Actions) {
+		t.Fatalf("tokens %d: Expected %d actions but got %d", i, len(expected.Actions), len(actual.Actions))
+	}
+	for j, e := range expected.Actions {
+		a := actual.Actions[j]
+		if e.Type != a.Type || e.Column != a.Column || e.Name != a.Name || e.NewName != a.NewName || e.IfExists != a.IfExists {
+			t.Fatalf("tokens %d: Expected action %+v but got %+v", i, e, a)
+		}
+		if e.Default != nil || a.Default != nil {
+			assertExpr(t, e.Default, a.Default, i)
+		}
+		if (e.Definition == nil) != (a.Definition == nil) || (e.Constraint == nil) != (a.Constraint == nil) {
+			t.Fatalf("tokens %d: Expected action %+v but got %+v", i, e, a)
+		}
+		if e.Definition != nil {
+			assertCreateTable(t, &CreateTable{Columns: []*ColumnDefinition{e.Definition}}, &CreateTable{Columns: []*ColumnDefinition{a.Definition}}, i)
+		}
+		if e.Constraint != nil {
+			assertCreateTable(t, &CreateTable{Constraints: []*TableConstraint{e.Constraint}}, &CreateTable{Constraints: []*TableConstraint{a.Constraint}}, i)
+		}
+	}
+}
+
+func assertDrop(t *testing.T, expected *Drop, actual *Drop, i int) {
+	if expected.Type != actual.Type || expected.IfExists != actual.IfExists || expected.Cascade != actual.Cascade || expected.Restrict != actual.Restrict {
+		t.Fatalf("tokens %d: Expected %+v but got %+v", i, expected, actual)
+	}
+	assertTableList(t, expected.Names, actual.Names, i)
+	assertTableReference(t, expected.Table, actual.Table, i)
+}
+
+func assertTruncate(t *testing.T, expected *Truncate, actual *Truncate, i int) {
+	if expected.RestartIdentity != actual.RestartIdentity || expected.Cascade != actual.Cascade || expected.Restrict != actual.Restrict {
+		t.Fatalf("tokens %d: Expected %+v but got %+v", i, expected, actual)
+	}
+	assertTableList(t, expected.Tables, actual.Tables, i)
+}
+
+func assertCreateIndex(t *testing.T, expected *CreateIndex, actual *CreateIndex, i int) {
+	assertTableReference(t, expected.Table, actual.Table, i)
+	if expected.Name != actual.Name || expected.Unique != actual.Unique || expected.Concurrently != actual.Concurrently ||
+		expected.IfNotExists != actual.IfNotExists || expected.Using != actual.Using {
+		t.Fatalf("tokens %d: Expected %+v but got %+v", i, expected, actual)
+	}
+	if len(expected.Columns) != len(actual.Columns) {
+		t.Fatalf("tokens %d: Expected %d columns but got %d", i, len(expected.Columns), len(actual.Columns))
+	}
+	for j, e := range expected.Columns {
+		a := actual.Columns[j]
+		if e.Column != a.Column || e.Length != a.Length || e.Desc != a.Desc {
+			t.Fatalf("tokens %d: Expected column %+v but got %+v", i, e, a)
+		}
+		if e.Expr != nil || a.Expr != nil {
+			assertExpr(t, e.Expr, a.Expr, i)
+		}
+	}
+	if expected.Where != nil || actual.Where != nil {
+		assertExpr(t, expected.Where, actual.Where, i)
+	}
+}
+
+func assertReferences(t *testing.T, expected *References, actual *References, i int) {
+	if (expected == nil) != (actual == nil) {
+		t.Fatalf("tokens %d: Expected REFERENCES %v but got %v", i, expected, actual)
+	}
+	if expected == nil {
+		return
+	}
+	assertTableReference(t, expected.Table, actual.Table, i)
+	if !reflect.DeepEqual(expected.Columns, actual.Columns) || expected.OnDelete != actual.OnDelete || expected.OnUpdate != actual.OnUpdate {
+		t.Fatalf("tokens %d: Expected REFERENCES %+v but got %+v", i, expected, actual)
+	}
+}
+
+func assertInsert(t *testing.T, expected *Insert, actual *Insert, i int) {
+	assertTableReference(t, expected.Table, actual.Table, i)
+	if !reflect.DeepEqual(expected.Columns, actual.Columns) {
+		t.Fatalf("tokens %d: Expected columns %v but got %v", i, expected.Columns, actual.Columns)
+	}
+	if len(expected.Values) != len(actual.Values) {
+		t.Fatalf("tokens %d: Expected %d rows but got %d", i, len(expected.Values), len(actual.Values))
+	}
+	for j, row := range expected.Values {
+		if len(row) != len(actual.Values[j]) {
+			t.Fatalf("tokens %d: Expected %d values but got %d", i, len(row), len(actual.Values[j]))
+		}
+		for k, v := range row {
+			assertExpr(t, v, actual.Values[j][k], i)
+		}
+	}
+	assertSelect(t, expected.Select, actual.Select, i)
+	assertAssignments(t, expected.OnDuplicateKeyUpdate, actual.OnDuplicateKeyUpdate, i)
+
+	if (expected.OnConflict == nil) != (actual.OnConflict == nil) {
+		t.Fatalf("tokens %d: Expected ON CONFLICT %v but got %v", i, expected.OnConflict, actual.OnConflict)
+	}
+	if e, a := expected.OnConflict, actual.OnConflict; e != nil {
+		if !reflect.DeepEqual(e.Columns, a.Columns) || e.Constraint != a.Constraint || e.DoNothing != a.DoNothing {
+			t.Fatalf("tokens %d: Expected ON CONFLICT %+v but got %+v", i, e, a)
+		}
+		assertAssignments(t, e.Update, a.Update, i)
+		assertExpr(t, e.Where, a.Where, i)
+	}
 }
 
 func assertComparisonExpr(t *testing.T, expected *ComparisonExpr, actual *ComparisonExpr) {
+	if expected.Operator != actual.Operator {
+		t.Fatalf("Expected operator %v but got %v", expected.Operator, actual.Operator)
+	}
 	assertValueExpr(t, expected.LeftValue, actual.LeftValue)
 	assertValueExpr(t, expected.RightValue, actual.RightValue)
 }
@@ -119,6 +369,18 @@ func assertValueExpr(t *testing.T, expected ValueExpr, actual ValueExpr) {
 		if expected.IntValue != actual.IntValue {
 			t.Fatalf("Expected int value %d but got %d", expected.IntValue, actual.IntValue)
 		}
+	case ValueTypeDecimal, ValueTypeFloat:
+		if expected.FloatValue != actual.FloatValue {
+			t.Fatalf("Expected float value %v but got %v", expected.FloatValue, actual.FloatValue)
+		}
+	case ValueTypeDynamicParameter:
+		if expected.ParameterIndex != actual.ParameterIndex {
+			t.Fatalf("Expected parameter index %d but got %d", expected.ParameterIndex, actual.ParameterIndex)
+		}
+	case ValueTypeNamedParameter:
+		if expected.ParameterName != actual.ParameterName {
+			t.Fatalf("Expected parameter name %s but got %s", expected.ParameterName, actual.ParameterName)
+		}
 	case ValueTypeParameter:
 		if reflect.DeepEqual(expected.Identifiers, actual.Identifiers) == false {
 			t.Fatalf("Expected %v but got %v", expected.Identifiers, actual.Identifiers)
@@ -176,8 +438,11 @@ func TestParser_Parse(t *testing.T) {
 		parser := Parser{}
 		for i, c := range TestSelectQuery {
 			t.Logf("testing: %s", c.Query)
-			tr := NewTokensReader(c.Tokens)
-			p, err := parser.Parse(tr)
+			tokens := c.Tokens
+			if tokens == nil {
+				tokens = scanTokens(t, c.Query)
+			}
+			p, err := parser.Parse(NewTokensReader(tokens))
 			if err != nil && err != io.EOF {
 				t.Fatalf("Failed parse tokens %d (%v): %v", i, c.Tokens, err)
 			}
@@ -187,12 +452,509 @@ func TestParser_Parse(t *testing.T) {
 			}
 
 			ast := c.Ast.(Select)
-			assertSelectList(t, ast.SelectList, s.SelectList, i)
-			assertFromClause(t, ast.Table.From, s.Table.From, i)
-			assertWhereClause(t, ast.Table.Where, s.Table.Where, i)
-			assertGroupByClause(t, ast.Table.GroupBy, s.Table.GroupBy, i)
-			assertHavingClause(t, ast.Table.Having, s.Table.Having, i)
-			assertOrderByClause(t, ast.OrderBy, s.OrderBy, i)
+			assertSelect(t, &ast, s, i)
+		}
+	})
+
+	t.Run("Insert", func(t *testing.T) {
+		parser := Parser{}
+		for i, c := range TestInsertQuery {
+			t.Logf("testing: %s", c.Query)
+			p, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+			if err != nil {
+				t.Fatalf("Failed parse query %d: %v", i, err)
+			}
+			s, ok := p.(*Insert)
+			if ok == false {
+				t.Fatalf("tokens %d, Expected Insert but got %T", i, p)
+			}
+			assertInsert(t, c.Ast.(*Insert), s, i)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		parser := Parser{}
+		for i, c := range TestUpdateQuery {
+			t.Logf("testing: %s", c.Query)
+			p, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+			if err != nil {
+				t.Fatalf("Failed parse query %d: %v", i, err)
+			}
+			s, ok := p.(*Update)
+			if ok == false {
+				t.Fatalf("tokens %d, Expected Update but got %T", i, p)
+			}
+			assertUpdate(t, c.Ast.(*Update), s, i)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		parser := Parser{}
+		for i, c := range TestDeleteQuery {
+			t.Logf("testing: %s", c.Query)
+			p, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+			if err != nil {
+				t.Fatalf("Failed parse query %d: %v", i, err)
+			}
+			s, ok := p.(*Delete)
+			if ok == false {
+				t.Fatalf("tokens %d, Expected Delete but got %T", i, p)
+			}
+			assertDelete(t, c.Ast.(*Delete), s, i)
+		}
+	})
+
+	t.Run("CreateTable", func(t *testing.T) {
+		parser := Parser{}
+		for i, c := range TestCreateTableQuery {
+			t.Logf("testing: %s", c.Query)
+			p, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+			if err != nil {
+				t.Fatalf("Failed parse query %d: %v", i, err)
+			}
+			s, ok := p.(*CreateTable)
+			if ok == false {
+				t.Fatalf("tokens %d, Expected CreateTable but got %T", i, p)
+			}
+			assertCreateTable(t, c.Ast.(*CreateTable), s, i)
+		}
+	})
+
+	t.Run("CreateIndex", func(t *testing.T) {
+		parser := Parser{}
+		for i, c := range TestCreateIndexQuery {
+			t.Logf("testing: %s", c.Query)
+			p, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+			if err != nil {
+				t.Fatalf("Failed parse query %d: %v", i, err)
+			}
+			s, ok := p.(*CreateIndex)
+			if ok == false {
+				t.Fatalf("tokens %d, Expected CreateIndex but got %T", i, p)
+			}
+			assertCreateIndex(t, c.Ast.(*CreateIndex), s, i)
+		}
+	})
+
+	t.Run("CreateView", func(t *testing.T) {
+		parser := Parser{}
+		for i, c := range TestCreateViewQuery {
+			t.Logf("testing: %s", c.Query)
+			p, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+			if err != nil {
+				t.Fatalf("Failed parse query %d: %v", i, err)
+			}
+			s, ok := p.(*CreateView)
+			if ok == false {
+				t.Fatalf("tokens %d, Expected CreateView but got %T", i, p)
+			}
+			e := c.Ast.(*CreateView)
+			assertTableReference(t, e.Name, s.Name, i)
+			if e.OrReplace != s.OrReplace || !reflect.DeepEqual(e.Columns, s.Columns) || e.WithCheckOption != s.WithCheckOption || e.LocalCheckOption != s.LocalCheckOption {
+				t.Fatalf("tokens %d: Expected %+v but got %+v", i, e, s)
+			}
+			assertSelect(t, e.Select, s.Select, i)
+		}
+	})
+
+	t.Run("CreateMaterializedView", func(t *testing.T) {
+		parser := Parser{}
+		for i, c := range TestCreateMaterializedViewQuery {
+			t.Logf("testing: %s", c.Query)
+			p, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+			if err != nil {
+				t.Fatalf("Failed parse query %d: %v", i, err)
+			}
+			s, ok := p.(*CreateMaterializedView)
+			if ok == false {
+				t.Fatalf("tokens %d, Expected CreateMaterializedView but got %T", i, p)
+			}
+			e := c.Ast.(*CreateMaterializedView)
+			assertTableReference(t, e.Name, s.Name, i)
+			if e.IfNotExists != s.IfNotExists || !reflect.DeepEqual(e.Columns, s.Columns) || e.WithNoData != s.WithNoData {
+				t.Fatalf("tokens %d: Expected %+v but got %+v", i, e, s)
+			}
+			assertSelect(t, e.Select, s.Select, i)
+		}
+	})
+
+	t.Run("RefreshMaterializedView", func(t *testing.T) {
+		parser := Parser{}
+		for i, c := range TestRefreshMaterializedViewQuery {
+			t.Logf("testing: %s", c.Query)
+			p, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+			if err != nil {
+				t.Fatalf("Failed parse query %d: %v", i, err)
+			}
+			s, ok := p.(*RefreshMaterializedView)
+			if ok == false {
+				t.Fatalf("tokens %d, Expected RefreshMaterializedView but got %T", i, p)
+			}
+			e := c.Ast.(*RefreshMaterializedView)
+			assertTableReference(t, e.Name, s.Name, i)
+			if e.Concurrently != s.Concurrently || e.WithNoData != s.WithNoData {
+				t.Fatalf("tokens %d: Expected %+v but got %+v", i, e, s)
+			}
+		}
+	})
+
+	t.Run("CreateDatabase", func(t *testing.T) {
+		parser := Parser{}
+		for i, c := range TestCreateDatabaseQuery {
+			t.Logf("testing: %s", c.Query)
+			p, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+			if err != nil {
+				t.Fatalf("Failed parse query %d: %v", i, err)
+			}
+			s, ok := p.(*CreateDatabase)
+			if ok == false {
+				t.Fatalf("tokens %d, Expected CreateDatabase but got %T", i, p)
+			}
+			if e := c.Ast.(*CreateDatabase); e.Name != s.Name || e.IfNotExists != s.IfNotExists || e.CharacterSet != s.CharacterSet || e.Collate != s.Collate || e.Owner != s.Owner {
+				t.Fatalf("tokens %d: Expected %+v but got %+v", i, e, s)
+			}
+		}
+	})
+
+	t.Run("CreateSchema", func(t *testing.T) {
+		parser := Parser{}
+		for i, c := range TestCreateSchemaQuery {
+			t.Logf("testing: %s", c.Query)
+			p, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+			if err != nil {
+				t.Fatalf("Failed parse query %d: %v", i, err)
+			}
+			s, ok := p.(*CreateSchema)
+			if ok == false {
+				t.Fatalf("tokens %d, Expected CreateSchema but got %T", i, p)
+			}
+			if e := c.Ast.(*CreateSchema); e.Name != s.Name || e.IfNotExists != s.IfNotExists || e.Authorization != s.Authorization || e.CharacterSet != s.CharacterSet || e.Collate != s.Collate {
+				t.Fatalf("tokens %d: Expected %+v but got %+v", i, e, s)
+			}
+		}
+	})
+
+	t.Run("AlterTable", func(t *testing.T) {
+		parser := Parser{}
+		for i, c := range TestAlterTableQuery {
+			t.Logf("testing: %s", c.Query)
+			p, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+			if err != nil {
+				t.Fatalf("Failed parse query %d: %v", i, err)
+			}
+			s, ok := p.(*AlterTable)
+			if ok == false {
+				t.Fatalf("tokens %d, Expected AlterTable but got %T", i, p)
+			}
+			assertAlterTable(t, c.Ast.(*AlterTable), s, i)
+		}
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		parser := Parser{}
+		for i, c := range TestDropQuery {
+			t.Logf("testing: %s", c.Query)
+			p, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+			if err != nil {
+				t.Fatalf("Failed parse query %d: %v", i, err)
+			}
+			s, ok := p.(*Drop)
+			if ok == false {
+				t.Fatalf("tokens %d, Expected Drop but got %T", i, p)
+			}
+			assertDrop(t, c.Ast.(*Drop), s, i)
+		}
+	})
+
+	t.Run("Truncate", func(t *testing.T) {
+		parser := Parser{}
+		for i, c := range TestTruncateQuery {
+			t.Logf("testing: %s", c.Query)
+			p, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+			if err != nil {
+				t.Fatalf("Failed parse query %d: %v", i, err)
+			}
+			s, ok := p.(*Truncate)
+			if ok == false {
+				t.Fatalf("tokens %d, Expected Truncate but got %T", i, p)
+			}
+			assertTruncate(t, c.Ast.(*Truncate), s, i)
+		}
+	})
+}
+
+func TestParse(t *testing.T) {
+	q, err := Parse("SELECT id FROM Users WHERE id = ", WithDialect(DialectPostgreSQL))
+	if err == nil {
+		t.Fatal("Expected an error for the missing operand")
+	}
+	if e, ok := err.(*ParseError); !ok || e.Excerpt == "" {
+		t.Fatalf("Expected *ParseError with the excerpt but got %v", err)
+	}
+
+	q, err = Parse("SELECT id FROM \"Users\" WHERE id = $1", WithDialect(DialectPostgreSQL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, ok := q.(*Select)
+	if !ok {
+		t.Fatalf("Expected Select but got %T", q)
+	}
+	assertFromClause(t, FromClause{Table: []TableReference{{Name: "Users"}}}, s.Table.From, 0)
+
+	q, err = ParseReader(strings.NewReader("select * from users where id = ?"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := q.(*Select); !ok {
+		t.Fatalf("Expected Select but got %T", q)
+	}
+
+	_, err = ParseReader(strings.NewReader("select * from users where id ="))
+	if !errors.Is(err, ErrInvalidQuery) {
+		t.Fatalf("Expected ErrInvalidQuery but got %v", err)
+	}
+}
+
+func TestParse_TrailingDelimiter(t *testing.T) {
+	for _, query := range []string{"SELECT * FROM t;", "DELETE FROM t;", "select * from t where a = 1 ;\n", "update t set a = 1; -- done"} {
+		if _, err := Parse(query); err != nil {
+			t.Errorf("Parse(%q): %v", query, err)
+		}
+		if _, err := ParseReader(strings.NewReader(query)); err != nil {
+			t.Errorf("ParseReader(%q): %v", query, err)
+		}
+	}
+
+	q, err := Parse("SELECT * FROM t;")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if end := q.End(); end.Offset != 15 {
+		t.Errorf("Expected the statement to end before the delimiter but got %+v", end)
+	}
+
+	for _, query := range []string{"SELECT * FROM t;;", "SELECT * FROM t; SELECT * FROM u", "; SELECT * FROM t"} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("Parse(%q): Expected an error", query)
+		}
+	}
+}
+
+func TestNode(t *testing.T) {
+	stmt, err := Parse("select * from users where id = 1 and name = 'a'  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertSpan := func(n Node, start, end int) {
+		t.Helper()
+		if n.Pos().Offset != start || n.End().Offset != end {
+			t.Fatalf("Expected %T spans %d-%d but got %d-%d", n, start, end, n.Pos().Offset, n.End().Offset)
+		}
+	}
+
+	s, ok := stmt.(*Select)
+	if !ok {
+		t.Fatalf("Expected *Select but got %T", stmt)
+	}
+	assertSpan(s, 0, 47)
+	assertSpan(s.Table.From.Table[0], 14, 19)
+
+	var comparisons []*ComparisonExpr
+	switch e := s.Table.Where.Cond.(type) {
+	case *BooleanTerm:
+		assertSpan(e, 26, 47)
+		for _, c := range []Expr{e.Left, e.Right} {
+			if v, ok := c.(*ComparisonExpr); ok {
+				comparisons = append(comparisons, v)
+			}
+		}
+	default:
+		t.Fatalf("Expected *BooleanTerm but got %T", e)
+	}
+	if len(comparisons) != 2 {
+		t.Fatalf("Expected 2 comparisons but got %d", len(comparisons))
+	}
+	assertSpan(comparisons[0], 26, 32)
+	assertSpan(comparisons[1].RightValue, 44, 47)
+}
+
+func TestParser_ParseError(t *testing.T) {
+	cases := []struct {
+		Query    string
+		Position Position
+		Expected []TokenType
+		Excerpt  string
+		// Message is checked only when it is not empty.
+		Message string
+	}{
+		{
+			Query:    "select * users",
+			Position: Position{Line: 1, Offset: 14, Column: 15},
+			Expected: []TokenType{FROM},
+			Excerpt:  "select * users\n              ^",
+		},
+		{
+			Query:    "select * from users\nwhere\tid = ",
+			Position: Position{Line: 2, Offset: 29, Column: 10},
+			Expected: valueTokenTypes,
+			Excerpt:  "where\tid = \n     \t   ^",
+		},
+		{
+			Query:    "select * from users where name = 'abc",
+			Position: Position{Line: 1, Offset: 33, Column: 34},
+			Expected: valueTokenTypes,
+			Excerpt:  "select * from users where name = 'abc\n                                 ^^^^",
+		},
+		{
+			Query:    "users foo",
+			Position: Position{Line: 1, Offset: 0, Column: 1},
+			Expected: statementTokenTypes,
+			Excerpt:  "users foo\n^^^^^",
+		},
+		{
+			Query:    "SELECT * FROM t ORDER",
+			Position: Position{Line: 1, Offset: 16, Column: 17},
+			Expected: []TokenType{EOF},
+			Excerpt:  "SELECT * FROM t ORDER\n                ^^^^^",
+			Message:  "ORDER is not followed by BY",
+		},
+		{
+			Query:    "SELECT * FROM t WHERE a = 1 GROUP",
+			Position: Position{Line: 1, Offset: 28, Column: 29},
+			Excerpt:  "SELECT * FROM t WHERE a = 1 GROUP\n                            ^^^^^",
+			Message:  "GROUP is not followed by BY",
+		},
+	}
+
+	for _, c := range cases {
+		parser := Parser{Source: c.Query}
+		_, err := parser.Parse(NewTokensReader(scanTokens(t, c.Query)))
+		if !errors.Is(err, ErrInvalidQuery) {
+			t.Fatalf("%s: Expected ErrInvalidQuery but got %v", c.Query, err)
+		}
+		e, ok := err.(*ParseError)
+		if !ok {
+			t.Fatalf("%s: Expected *ParseError but got %T", c.Query, err)
+		}
+		if e.Position != c.Position {
+			t.Errorf("%s: Expected position %+v but got %+v", c.Query, c.Position, e.Position)
+		}
+		if !reflect.DeepEqual(e.Expected, c.Expected) {
+			t.Errorf("%s: Expected %v but got %v", c.Query, c.Expected, e.Expected)
+		}
+		if e.Excerpt != c.Excerpt {
+			t.Errorf("%s: Expected excerpt\n%s\nbut got\n%s", c.Query, c.Excerpt, e.Excerpt)
+		}
+		if c.Message != "" && e.Message != c.Message {
+			t.Errorf("%s: Expected message %q but got %q", c.Query, c.Message, e.Message)
+		}
+	}
+}
+
+func TestParser_ParseErrorQuery(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Queries []TestErrorQuery
+	}{
+		{"Select", TestSelectErrorQuery},
+		{"Insert", TestInsertErrorQuery},
+		{"Update", TestUpdateErrorQuery},
+		{"Delete", TestDeleteErrorQuery},
+		{"CreateTable", TestCreateTableErrorQuery},
+		{"CreateView", TestCreateViewErrorQuery},
+		{"AlterTable", TestAlterTableErrorQuery},
+		{"CreateDatabase", TestCreateDatabaseErrorQuery},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			for i, q := range c.Queries {
+				parser := Parser{Source: q.Query}
+				_, err := parser.Parse(NewTokensReader(scanTokens(t, q.Query)))
+				e, ok := err.(*ParseError)
+				if !ok {
+					t.Fatalf("query %d: Expected *ParseError for %s but got %v", i, q.Query, err)
+				}
+				if e.Position != q.Position {
+					t.Errorf("query %d: Expected position %+v but got %+v: %v", i, q.Position, e.Position, e)
+				}
+			}
+		})
+	}
+}
+
+func scanTokens(t *testing.T, query string, opts ...LexerOption) []Token {
+	l := NewLexer(strings.NewReader(query), opts...)
+	tokens := make([]Token, 0)
+	for {
+		token, err := l.Scan()
+		if err != nil && err != io.EOF {
+			t.Fatal(err)
+		}
+		tokens = append(tokens, token)
+		if err == io.EOF {
+			return tokens
+		}
+	}
+}
+
+func TestParser_ParseScript(t *testing.T) {
+	script := "select * from a;\nselect * from b;\nDELIMITER $$\nselect * from c$$\nDELIMITER ;\nselect * from d"
+
+	parser := Parser{}
+	statements, err := parser.ParseScript(NewTokensReader(scanTokens(t, script)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statements) != 4 {
+		t.Fatalf("Expected 4 statements but got %d", len(statements))
+	}
+	for i, table := range []string{"a", "b", "c", "d"} {
+		s, ok := statements[i].Query.(*Select)
+		if !ok {
+			t.Fatalf("statement %d: Expected Select but got %T", i, statements[i].Query)
+		}
+		assertFromClause(t, FromClause{Table: []TableReference{{Name: table}}}, s.Table.From, i)
+	}
+	if statements[1].Start != (Position{Line: 2, Offset: 17, Column: 1}) || statements[1].End != (Position{Line: 2, Offset: 32, Column: 16}) {
+		t.Fatalf("Unexpected span %+v - %+v", statements[1].Start, statements[1].End)
+	}
+
+	t.Run("LexerReader", func(t *testing.T) {
+		script := strings.Repeat("select * from users where id = 1;\n", 1000)
+
+		statements, err := (&Parser{}).ParseScript(NewLexerReader(NewLexer(strings.NewReader(script))))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(statements) != 1000 {
+			t.Fatalf("Expected 1000 statements but got %d", len(statements))
+		}
+		if statements[999].Start.Line != 1000 {
+			t.Fatalf("Expected the last statement at line 1000 but got %d", statements[999].Start.Line)
+		}
+	})
+
+	t.Run("ContinueOnError", func(t *testing.T) {
+		script := "select * from a; foo bar; select * from b"
+
+		_, err := (&Parser{}).ParseScript(NewTokensReader(scanTokens(t, script)))
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+
+		parser := Parser{ContinueOnError: true}
+		statements, err := parser.ParseScript(NewTokensReader(scanTokens(t, script)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(statements) != 3 {
+			t.Fatalf("Expected 3 statements but got %d", len(statements))
+		}
+		if statements[1].Err == nil || statements[0].Err != nil || statements[2].Err != nil {
+			t.Fatalf("Expected only the second statement fails")
 		}
 	})
 }
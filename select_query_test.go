@@ -3,18 +3,24 @@ package parser
 type TestQuery struct {
 	Query  string
 	Tokens []Token
-	Ast    Query
+	Ast    Node
+}
+
+// TestErrorQuery is a query which the parser rejects with a *ParseError at Position.
+type TestErrorQuery struct {
+	Query    string
+	Position Position
 }
 
 var TestSelectQuery = []TestQuery{
 	{ // # 0
 		Query: "select * from test",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-			{Type: IDENT, Value: "test", Position: Position{Line: 1, Offset: 14, Column: 4}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 18}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "test", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 18, Column: 19}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 18, Column: 19}, End: Position{Line: 1, Offset: 18, Column: 19}},
 		},
 		Ast: Select{
 			SelectList: []SelectExpr{{Asterisk: true}},
@@ -24,25 +30,25 @@ var TestSelectQuery = []TestQuery{
 	{ // # 1
 		Query: "select * from `test`",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-			{Type: IDENT, Value: "`test`", Position: Position{Line: 1, Offset: 14, Column: 6}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 20}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: QUOTED_IDENT, Value: "test", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 20, Column: 21}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 20, Column: 21}},
 		},
 		Ast: Select{
 			SelectList: []SelectExpr{{Asterisk: true}},
-			Table:      TableExpression{From: FromClause{Table: []TableReference{{Name: "`test`"}}}},
+			Table:      TableExpression{From: FromClause{Table: []TableReference{{Name: "test"}}}},
 		},
 	},
 	{ // # 2
 		Query: "select    *    from    test",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: ASTERISK, Position: Position{Line: 1, Offset: 10, Column: 1}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 15, Column: 4}},
-			{Type: IDENT, Value: "test", Position: Position{Line: 1, Offset: 23, Column: 4}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 27}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 10, Column: 11}, End: Position{Line: 1, Offset: 11, Column: 12}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 15, Column: 16}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: IDENT, Value: "test", Position: Position{Line: 1, Offset: 23, Column: 24}, End: Position{Line: 1, Offset: 27, Column: 28}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 27, Column: 28}, End: Position{Line: 1, Offset: 27, Column: 28}},
 		},
 		Ast: Select{
 			SelectList: []SelectExpr{{Asterisk: true}},
@@ -52,13 +58,13 @@ var TestSelectQuery = []TestQuery{
 	{
 		Query: "SELECT id,age from users",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 7, Column: 2}},
-			{Type: COMMA, Position: Position{Line: 1, Offset: 9, Column: 1}},
-			{Type: IDENT, Value: "age", Position: Position{Line: 1, Offset: 10, Column: 3}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 14, Column: 4}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 19, Column: 5}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 24}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 9, Column: 10}},
+			{Type: COMMA, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 10, Column: 11}},
+			{Type: IDENT, Value: "age", Position: Position{Line: 1, Offset: 10, Column: 11}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 18, Column: 19}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 19, Column: 20}, End: Position{Line: 1, Offset: 24, Column: 25}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 24, Column: 25}, End: Position{Line: 1, Offset: 24, Column: 25}},
 		},
 		Ast: Select{
 			SelectList: []SelectExpr{{Column: "id"}, {Column: "age"}},
@@ -70,15 +76,15 @@ var TestSelectQuery = []TestQuery{
 	{
 		Query: "select * from users where id = 1",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 5}},
-			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 2}},
-			{Type: EQUAL, Position: Position{Line: 1, Offset: 29, Column: 1}},
-			{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 31, Column: 1}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 27}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 28, Column: 29}},
+			{Type: EQUAL, Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 30, Column: 31}},
+			{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 32, Column: 33}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 32, Column: 33}, End: Position{Line: 1, Offset: 32, Column: 33}},
 		},
 		Ast: Select{
 			Table: TableExpression{
@@ -87,7 +93,7 @@ var TestSelectQuery = []TestQuery{
 				},
 				Where: WhereClause{Cond: &ComparisonExpr{
 					Operator:   ComparisonOperatorEqual,
-					LeftValue:  ValueExpr{Type: ValueTypeString, StringValue: "id"},
+					LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"id"}},
 					RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 1},
 				}},
 			},
@@ -97,19 +103,19 @@ var TestSelectQuery = []TestQuery{
 	{
 		Query: "select * from users where id = 1 and age = 20",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 5}},
-			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 2}},
-			{Type: EQUAL, Position: Position{Line: 1, Offset: 29, Column: 1}},
-			{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 31, Column: 1}},
-			{Type: AND, Position: Position{Line: 1, Offset: 33, Column: 3}},
-			{Type: IDENT, Value: "age", Position: Position{Line: 1, Offset: 37, Column: 3}},
-			{Type: EQUAL, Position: Position{Line: 1, Offset: 41, Column: 1}},
-			{Type: INT, IntValue: 20, Position: Position{Line: 1, Offset: 43, Column: 2}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 45}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 28, Column: 29}},
+			{Type: EQUAL, Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 30, Column: 31}},
+			{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 32, Column: 33}},
+			{Type: AND, Position: Position{Line: 1, Offset: 33, Column: 34}, End: Position{Line: 1, Offset: 36, Column: 37}},
+			{Type: IDENT, Value: "age", Position: Position{Line: 1, Offset: 37, Column: 38}, End: Position{Line: 1, Offset: 40, Column: 41}},
+			{Type: EQUAL, Position: Position{Line: 1, Offset: 41, Column: 42}, End: Position{Line: 1, Offset: 42, Column: 43}},
+			{Type: INT, IntValue: 20, Position: Position{Line: 1, Offset: 43, Column: 44}, End: Position{Line: 1, Offset: 45, Column: 46}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 45, Column: 46}, End: Position{Line: 1, Offset: 45, Column: 46}},
 		},
 		Ast: Select{
 			Table: TableExpression{
@@ -121,12 +127,12 @@ var TestSelectQuery = []TestQuery{
 						Boolean: Token{Type: AND},
 						Left: &ComparisonExpr{
 							Operator:   ComparisonOperatorEqual,
-							LeftValue:  ValueExpr{Type: ValueTypeString, StringValue: "id"},
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"id"}},
 							RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 1},
 						},
 						Right: &ComparisonExpr{
 							Operator:   ComparisonOperatorEqual,
-							LeftValue:  ValueExpr{Type: ValueTypeString, StringValue: "age"},
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"age"}},
 							RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 20},
 						},
 					},
@@ -138,19 +144,19 @@ var TestSelectQuery = []TestQuery{
 	{
 		Query: "select * from users where id > 10 and age > 20",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 5}},
-			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 2}},
-			{Type: GTR, Position: Position{Line: 1, Offset: 29, Column: 1}},
-			{Type: INT, IntValue: 10, Position: Position{Line: 1, Offset: 31, Column: 2}},
-			{Type: AND, Position: Position{Line: 1, Offset: 34, Column: 3}},
-			{Type: IDENT, Value: "age", Position: Position{Line: 1, Offset: 38, Column: 3}},
-			{Type: GTR, Position: Position{Line: 1, Offset: 42, Column: 1}},
-			{Type: INT, IntValue: 20, Position: Position{Line: 1, Offset: 44, Column: 2}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 46}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 28, Column: 29}},
+			{Type: GTR, Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 30, Column: 31}},
+			{Type: INT, IntValue: 10, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 33, Column: 34}},
+			{Type: AND, Position: Position{Line: 1, Offset: 34, Column: 35}, End: Position{Line: 1, Offset: 37, Column: 38}},
+			{Type: IDENT, Value: "age", Position: Position{Line: 1, Offset: 38, Column: 39}, End: Position{Line: 1, Offset: 41, Column: 42}},
+			{Type: GTR, Position: Position{Line: 1, Offset: 42, Column: 43}, End: Position{Line: 1, Offset: 43, Column: 44}},
+			{Type: INT, IntValue: 20, Position: Position{Line: 1, Offset: 44, Column: 45}, End: Position{Line: 1, Offset: 46, Column: 47}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 46, Column: 47}, End: Position{Line: 1, Offset: 46, Column: 47}},
 		},
 		Ast: Select{
 			Table: TableExpression{
@@ -162,12 +168,12 @@ var TestSelectQuery = []TestQuery{
 						Boolean: Token{Type: AND},
 						Left: &ComparisonExpr{
 							Operator:   ComparisonOperatorGreaterThan,
-							LeftValue:  ValueExpr{Type: ValueTypeString, StringValue: "id"},
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"id"}},
 							RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 10},
 						},
 						Right: &ComparisonExpr{
 							Operator:   ComparisonOperatorGreaterThan,
-							LeftValue:  ValueExpr{Type: ValueTypeString, StringValue: "age"},
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"age"}},
 							RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 20},
 						},
 					},
@@ -179,14 +185,14 @@ var TestSelectQuery = []TestQuery{
 	{
 		Query: "select * from users order by created_date desc",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-			{Type: ORDERBY, Position: Position{Line: 1, Offset: 20, Column: 8}},
-			{Type: IDENT, Value: "created_date", Position: Position{Line: 1, Offset: 29, Column: 12}},
-			{Type: DESC, Position: Position{Line: 1, Offset: 42, Column: 4}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 46}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: ORDERBY, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 28, Column: 29}},
+			{Type: IDENT, Value: "created_date", Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 41, Column: 42}},
+			{Type: DESC, Position: Position{Line: 1, Offset: 42, Column: 43}, End: Position{Line: 1, Offset: 46, Column: 47}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 46, Column: 47}, End: Position{Line: 1, Offset: 46, Column: 47}},
 		},
 		Ast: Select{
 			Table: TableExpression{
@@ -202,16 +208,16 @@ var TestSelectQuery = []TestQuery{
 	{
 		Query: "select * from users order by created_date desc, rank",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-			{Type: ORDERBY, Position: Position{Line: 1, Offset: 20, Column: 8}},
-			{Type: IDENT, Value: "created_date", Position: Position{Line: 1, Offset: 29, Column: 12}},
-			{Type: DESC, Position: Position{Line: 1, Offset: 42, Column: 4}},
-			{Type: COMMA, Position: Position{Line: 1, Offset: 46, Column: 1}},
-			{Type: IDENT, Value: "rank", Position: Position{Line: 1, Offset: 48, Column: 4}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 52}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: ORDERBY, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 28, Column: 29}},
+			{Type: IDENT, Value: "created_date", Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 41, Column: 42}},
+			{Type: DESC, Position: Position{Line: 1, Offset: 42, Column: 43}, End: Position{Line: 1, Offset: 46, Column: 47}},
+			{Type: COMMA, Position: Position{Line: 1, Offset: 46, Column: 47}, End: Position{Line: 1, Offset: 47, Column: 48}},
+			{Type: IDENT, Value: "rank", Position: Position{Line: 1, Offset: 48, Column: 49}, End: Position{Line: 1, Offset: 52, Column: 53}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 52, Column: 53}, End: Position{Line: 1, Offset: 52, Column: 53}},
 		},
 		Ast: Select{
 			Table: TableExpression{
@@ -227,13 +233,13 @@ var TestSelectQuery = []TestQuery{
 	{
 		Query: "select * from users group by group_id",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-			{Type: GROUPBY, Position: Position{Line: 1, Offset: 20, Column: 8}},
-			{Type: IDENT, Value: "group_id", Position: Position{Line: 1, Offset: 29, Column: 8}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 37}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: GROUPBY, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 28, Column: 29}},
+			{Type: IDENT, Value: "group_id", Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 37, Column: 38}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 37, Column: 38}, End: Position{Line: 1, Offset: 37, Column: 38}},
 		},
 		Ast: Select{
 			Table: TableExpression{
@@ -249,17 +255,17 @@ var TestSelectQuery = []TestQuery{
 	{
 		Query: "select * from users group by group_id having group_id > 10",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-			{Type: GROUPBY, Position: Position{Line: 1, Offset: 20, Column: 8}},
-			{Type: IDENT, Value: "group_id", Position: Position{Line: 1, Offset: 29, Column: 8}},
-			{Type: HAVING, Position: Position{Line: 1, Offset: 38, Column: 6}},
-			{Type: IDENT, Value: "group_id", Position: Position{Line: 1, Offset: 45, Column: 8}},
-			{Type: GTR, Position: Position{Line: 1, Offset: 54, Column: 1}},
-			{Type: INT, IntValue: 10, Position: Position{Line: 1, Offset: 56, Column: 2}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 58}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: GROUPBY, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 28, Column: 29}},
+			{Type: IDENT, Value: "group_id", Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 37, Column: 38}},
+			{Type: HAVING, Position: Position{Line: 1, Offset: 38, Column: 39}, End: Position{Line: 1, Offset: 44, Column: 45}},
+			{Type: IDENT, Value: "group_id", Position: Position{Line: 1, Offset: 45, Column: 46}, End: Position{Line: 1, Offset: 53, Column: 54}},
+			{Type: GTR, Position: Position{Line: 1, Offset: 54, Column: 55}, End: Position{Line: 1, Offset: 55, Column: 56}},
+			{Type: INT, IntValue: 10, Position: Position{Line: 1, Offset: 56, Column: 57}, End: Position{Line: 1, Offset: 58, Column: 59}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 58, Column: 59}, End: Position{Line: 1, Offset: 58, Column: 59}},
 		},
 		Ast: Select{
 			Table: TableExpression{
@@ -271,7 +277,7 @@ var TestSelectQuery = []TestQuery{
 				Having: HavingClause{
 					Cond: &ComparisonExpr{
 						Operator:   ComparisonOperatorGreaterThan,
-						LeftValue:  ValueExpr{Type: ValueTypeString, StringValue: "group_id"},
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"group_id"}},
 						RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 10},
 					},
 				},
@@ -282,29 +288,29 @@ var TestSelectQuery = []TestQuery{
 	{
 		Query: "select * from users left outer join blog on users.id = blog.user_id",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-			{Type: LEFT, Position: Position{Line: 1, Offset: 20, Column: 4}},
-			{Type: OUTER, Position: Position{Line: 1, Offset: 25, Column: 5}},
-			{Type: JOIN, Position: Position{Line: 1, Offset: 31, Column: 4}},
-			{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 36, Column: 4}},
-			{Type: ON, Position: Position{Line: 1, Offset: 41, Column: 2}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 44, Column: 5}},
-			{Type: PERIOD, Position: Position{Line: 1, Offset: 49, Column: 1}},
-			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 50, Column: 2}},
-			{Type: EQUAL, Position: Position{Line: 1, Offset: 53, Column: 1}},
-			{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 55, Column: 4}},
-			{Type: PERIOD, Position: Position{Line: 1, Offset: 59, Column: 1}},
-			{Type: IDENT, Value: "user_id", Position: Position{Line: 1, Offset: 60, Column: 7}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 67}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: LEFT, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 24, Column: 25}},
+			{Type: OUTER, Position: Position{Line: 1, Offset: 25, Column: 26}, End: Position{Line: 1, Offset: 30, Column: 31}},
+			{Type: JOIN, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 35, Column: 36}},
+			{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 36, Column: 37}, End: Position{Line: 1, Offset: 40, Column: 41}},
+			{Type: ON, Position: Position{Line: 1, Offset: 41, Column: 42}, End: Position{Line: 1, Offset: 43, Column: 44}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 44, Column: 45}, End: Position{Line: 1, Offset: 49, Column: 50}},
+			{Type: PERIOD, Position: Position{Line: 1, Offset: 49, Column: 50}, End: Position{Line: 1, Offset: 50, Column: 51}},
+			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 50, Column: 51}, End: Position{Line: 1, Offset: 52, Column: 53}},
+			{Type: EQUAL, Position: Position{Line: 1, Offset: 53, Column: 54}, End: Position{Line: 1, Offset: 54, Column: 55}},
+			{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 55, Column: 56}, End: Position{Line: 1, Offset: 59, Column: 60}},
+			{Type: PERIOD, Position: Position{Line: 1, Offset: 59, Column: 60}, End: Position{Line: 1, Offset: 60, Column: 61}},
+			{Type: IDENT, Value: "user_id", Position: Position{Line: 1, Offset: 60, Column: 61}, End: Position{Line: 1, Offset: 67, Column: 68}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 67, Column: 68}, End: Position{Line: 1, Offset: 67, Column: 68}},
 		},
 		Ast: Select{
 			Table: TableExpression{
 				From: FromClause{
 					Table: []TableReference{{Name: "users"}},
-					Join: JoinedTable{
+					Join: []JoinedTable{{
 						Type:  []Token{{Type: LEFT}, {Type: OUTER}},
 						Table: []TableReference{{Name: "blog"}},
 						Cond: &ComparisonExpr{
@@ -312,7 +318,7 @@ var TestSelectQuery = []TestQuery{
 							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"users", "id"}},
 							RightValue: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"blog", "user_id"}},
 						},
-					},
+					}},
 				},
 			},
 			SelectList: []SelectExpr{{Asterisk: true}},
@@ -321,29 +327,29 @@ var TestSelectQuery = []TestQuery{
 	{
 		Query: "select * from users right outer join blog on users.id = blog.user_id",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-			{Type: RIGHT, Position: Position{Line: 1, Offset: 20, Column: 5}},
-			{Type: OUTER, Position: Position{Line: 1, Offset: 26, Column: 5}},
-			{Type: JOIN, Position: Position{Line: 1, Offset: 32, Column: 4}},
-			{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 37, Column: 4}},
-			{Type: ON, Position: Position{Line: 1, Offset: 42, Column: 2}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 45, Column: 5}},
-			{Type: PERIOD, Position: Position{Line: 1, Offset: 50, Column: 1}},
-			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 51, Column: 2}},
-			{Type: EQUAL, Position: Position{Line: 1, Offset: 54, Column: 1}},
-			{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 56, Column: 4}},
-			{Type: PERIOD, Position: Position{Line: 1, Offset: 60, Column: 1}},
-			{Type: IDENT, Value: "user_id", Position: Position{Line: 1, Offset: 61, Column: 7}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 68}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: RIGHT, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+			{Type: OUTER, Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 31, Column: 32}},
+			{Type: JOIN, Position: Position{Line: 1, Offset: 32, Column: 33}, End: Position{Line: 1, Offset: 36, Column: 37}},
+			{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 37, Column: 38}, End: Position{Line: 1, Offset: 41, Column: 42}},
+			{Type: ON, Position: Position{Line: 1, Offset: 42, Column: 43}, End: Position{Line: 1, Offset: 44, Column: 45}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 45, Column: 46}, End: Position{Line: 1, Offset: 50, Column: 51}},
+			{Type: PERIOD, Position: Position{Line: 1, Offset: 50, Column: 51}, End: Position{Line: 1, Offset: 51, Column: 52}},
+			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 51, Column: 52}, End: Position{Line: 1, Offset: 53, Column: 54}},
+			{Type: EQUAL, Position: Position{Line: 1, Offset: 54, Column: 55}, End: Position{Line: 1, Offset: 55, Column: 56}},
+			{Type: IDENT, Value: "blog", Position: Position{Line: 1, Offset: 56, Column: 57}, End: Position{Line: 1, Offset: 60, Column: 61}},
+			{Type: PERIOD, Position: Position{Line: 1, Offset: 60, Column: 61}, End: Position{Line: 1, Offset: 61, Column: 62}},
+			{Type: IDENT, Value: "user_id", Position: Position{Line: 1, Offset: 61, Column: 62}, End: Position{Line: 1, Offset: 68, Column: 69}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 68, Column: 69}, End: Position{Line: 1, Offset: 68, Column: 69}},
 		},
 		Ast: Select{
 			Table: TableExpression{
 				From: FromClause{
 					Table: []TableReference{{Name: "users"}},
-					Join: JoinedTable{
+					Join: []JoinedTable{{
 						Type:  []Token{{Type: RIGHT}, {Type: OUTER}},
 						Table: []TableReference{{Name: "blog"}},
 						Cond: &ComparisonExpr{
@@ -351,7 +357,7 @@ var TestSelectQuery = []TestQuery{
 							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"users", "id"}},
 							RightValue: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"blog", "user_id"}},
 						},
-					},
+					}},
 				},
 			},
 			SelectList: []SelectExpr{{Asterisk: true}},
@@ -360,13 +366,13 @@ var TestSelectQuery = []TestQuery{
 	{ // # 13
 		Query: "SELECT id as foo from users",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 7, Column: 2}},
-			{Type: AS, Position: Position{Line: 1, Offset: 10, Column: 2}},
-			{Type: IDENT, Value: "foo", Position: Position{Line: 1, Offset: 13, Column: 3}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 17, Column: 4}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 22, Column: 5}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 27}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 9, Column: 10}},
+			{Type: AS, Position: Position{Line: 1, Offset: 10, Column: 11}, End: Position{Line: 1, Offset: 12, Column: 13}},
+			{Type: IDENT, Value: "foo", Position: Position{Line: 1, Offset: 13, Column: 14}, End: Position{Line: 1, Offset: 16, Column: 17}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 17, Column: 18}, End: Position{Line: 1, Offset: 21, Column: 22}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 22, Column: 23}, End: Position{Line: 1, Offset: 27, Column: 28}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 27, Column: 28}, End: Position{Line: 1, Offset: 27, Column: 28}},
 		},
 		Ast: Select{
 			Table: TableExpression{
@@ -380,15 +386,259 @@ var TestSelectQuery = []TestQuery{
 	{ // # 14
 		Query: "SELECT * FROM users WHERE name = ?",
 		Tokens: []Token{
-			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 6}},
-			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 1}},
-			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 4}},
-			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 5}},
-			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 5}},
-			{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 26, Column: 4}},
-			{Type: EQUAL, Position: Position{Line: 1, Offset: 31, Column: 1}},
-			{Type: QUESTION, Position: Position{Line: 1, Offset: 33, Column: 1}},
-			{Type: EOF, Position: Position{Line: 1, Offset: 35}},
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+			{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 30, Column: 31}},
+			{Type: EQUAL, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 32, Column: 33}},
+			{Type: QUESTION, Position: Position{Line: 1, Offset: 33, Column: 34}, End: Position{Line: 1, Offset: 34, Column: 35}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 34, Column: 35}, End: Position{Line: 1, Offset: 34, Column: 35}},
+		},
+		Ast: Select{
+			SelectList: []SelectExpr{{Asterisk: true}},
+			Table: TableExpression{
+				From: FromClause{
+					Table: []TableReference{{Name: "users"}},
+				},
+				Where: WhereClause{
+					Cond: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"name"}},
+						RightValue: ValueExpr{Type: ValueTypeDynamicParameter, ParameterIndex: 1},
+					},
+				},
+			},
+		},
+	},
+	{ // # 15
+		Query: "SELECT * FROM users WHERE name = 'alice bob'",
+		Tokens: []Token{
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+			{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 30, Column: 31}},
+			{Type: EQUAL, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 32, Column: 33}},
+			{Type: STRING, Value: "alice bob", Position: Position{Line: 1, Offset: 33, Column: 34}, End: Position{Line: 1, Offset: 44, Column: 45}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 44, Column: 45}, End: Position{Line: 1, Offset: 44, Column: 45}},
+		},
+		Ast: Select{
+			SelectList: []SelectExpr{{Asterisk: true}},
+			Table: TableExpression{
+				From: FromClause{
+					Table: []TableReference{{Name: "users"}},
+				},
+				Where: WhereClause{
+					Cond: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"name"}},
+						RightValue: ValueExpr{Type: ValueTypeString, StringValue: "alice bob"},
+					},
+				},
+			},
+		},
+	},
+	{ // # 16
+		Query: "SELECT `Order` AS `select` FROM `from`",
+		Tokens: []Token{
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: QUOTED_IDENT, Value: "Order", Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 14, Column: 15}},
+			{Type: AS, Position: Position{Line: 1, Offset: 15, Column: 16}, End: Position{Line: 1, Offset: 17, Column: 18}},
+			{Type: QUOTED_IDENT, Value: "select", Position: Position{Line: 1, Offset: 18, Column: 19}, End: Position{Line: 1, Offset: 26, Column: 27}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 27, Column: 28}, End: Position{Line: 1, Offset: 31, Column: 32}},
+			{Type: QUOTED_IDENT, Value: "from", Position: Position{Line: 1, Offset: 32, Column: 33}, End: Position{Line: 1, Offset: 38, Column: 39}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 38, Column: 39}, End: Position{Line: 1, Offset: 38, Column: 39}},
+		},
+		Ast: Select{
+			SelectList: []SelectExpr{{Column: "Order", Alias: "select"}},
+			Table: TableExpression{
+				From: FromClause{Table: []TableReference{{Name: "from"}}},
+			},
+		},
+	},
+	{ // # 17
+		Query: "select * from items where price > -1.5 and stock < -3",
+		Tokens: []Token{
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "items", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+			{Type: IDENT, Value: "price", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 31, Column: 32}},
+			{Type: GTR, Position: Position{Line: 1, Offset: 32, Column: 33}, End: Position{Line: 1, Offset: 33, Column: 34}},
+			{Type: MINUS, Position: Position{Line: 1, Offset: 34, Column: 35}, End: Position{Line: 1, Offset: 35, Column: 36}},
+			{Type: DECIMAL, Value: "1.5", FloatValue: 1.5, Position: Position{Line: 1, Offset: 35, Column: 36}, End: Position{Line: 1, Offset: 38, Column: 39}},
+			{Type: AND, Position: Position{Line: 1, Offset: 39, Column: 40}, End: Position{Line: 1, Offset: 42, Column: 43}},
+			{Type: IDENT, Value: "stock", Position: Position{Line: 1, Offset: 43, Column: 44}, End: Position{Line: 1, Offset: 48, Column: 49}},
+			{Type: LSS, Position: Position{Line: 1, Offset: 49, Column: 50}, End: Position{Line: 1, Offset: 50, Column: 51}},
+			{Type: MINUS, Position: Position{Line: 1, Offset: 51, Column: 52}, End: Position{Line: 1, Offset: 52, Column: 53}},
+			{Type: INT, IntValue: 3, Position: Position{Line: 1, Offset: 52, Column: 53}, End: Position{Line: 1, Offset: 53, Column: 54}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 53, Column: 54}, End: Position{Line: 1, Offset: 53, Column: 54}},
+		},
+		Ast: Select{
+			SelectList: []SelectExpr{{Asterisk: true}},
+			Table: TableExpression{
+				From: FromClause{
+					Table: []TableReference{{Name: "items"}},
+				},
+				Where: WhereClause{
+					Cond: &BooleanTerm{
+						Boolean: Token{Type: AND},
+						Left: &ComparisonExpr{
+							Operator:   ComparisonOperatorGreaterThan,
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"price"}},
+							RightValue: ValueExpr{Type: ValueTypeDecimal, FloatValue: -1.5},
+						},
+						Right: &ComparisonExpr{
+							Operator:   ComparisonOperatorLessThan,
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"stock"}},
+							RightValue: ValueExpr{Type: ValueTypeInt, IntValue: -3},
+						},
+					},
+				},
+			},
+		},
+	},
+	{ // # 18
+		Query: "select * from users where age >= 20 and rank <> 1",
+		Tokens: []Token{
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+			{Type: IDENT, Value: "age", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 29, Column: 30}},
+			{Type: GEQ, Position: Position{Line: 1, Offset: 30, Column: 31}, End: Position{Line: 1, Offset: 32, Column: 33}},
+			{Type: INT, IntValue: 20, Position: Position{Line: 1, Offset: 33, Column: 34}, End: Position{Line: 1, Offset: 35, Column: 36}},
+			{Type: AND, Position: Position{Line: 1, Offset: 36, Column: 37}, End: Position{Line: 1, Offset: 39, Column: 40}},
+			{Type: IDENT, Value: "rank", Position: Position{Line: 1, Offset: 40, Column: 41}, End: Position{Line: 1, Offset: 44, Column: 45}},
+			{Type: NEQ, Position: Position{Line: 1, Offset: 45, Column: 46}, End: Position{Line: 1, Offset: 47, Column: 48}},
+			{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 48, Column: 49}, End: Position{Line: 1, Offset: 49, Column: 50}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 49, Column: 50}, End: Position{Line: 1, Offset: 49, Column: 50}},
+		},
+		Ast: Select{
+			SelectList: []SelectExpr{{Asterisk: true}},
+			Table: TableExpression{
+				From: FromClause{
+					Table: []TableReference{{Name: "users"}},
+				},
+				Where: WhereClause{
+					Cond: &BooleanTerm{
+						Boolean: Token{Type: AND},
+						Left: &ComparisonExpr{
+							Operator:   ComparisonOperatorGreaterThanOrEqual,
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"age"}},
+							RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 20},
+						},
+						Right: &ComparisonExpr{
+							Operator:   ComparisonOperatorNotEqual,
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"rank"}},
+							RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 1},
+						},
+					},
+				},
+			},
+		},
+	},
+	{ // # 19
+		Query: "select * from users where id = ? and name = ?",
+		Tokens: []Token{
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 28, Column: 29}},
+			{Type: EQUAL, Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 30, Column: 31}},
+			{Type: QUESTION, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 32, Column: 33}},
+			{Type: AND, Position: Position{Line: 1, Offset: 33, Column: 34}, End: Position{Line: 1, Offset: 36, Column: 37}},
+			{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 37, Column: 38}, End: Position{Line: 1, Offset: 41, Column: 42}},
+			{Type: EQUAL, Position: Position{Line: 1, Offset: 42, Column: 43}, End: Position{Line: 1, Offset: 43, Column: 44}},
+			{Type: QUESTION, Position: Position{Line: 1, Offset: 44, Column: 45}, End: Position{Line: 1, Offset: 45, Column: 46}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 45, Column: 46}, End: Position{Line: 1, Offset: 45, Column: 46}},
+		},
+		Ast: Select{
+			SelectList: []SelectExpr{{Asterisk: true}},
+			Table: TableExpression{
+				From: FromClause{
+					Table: []TableReference{{Name: "users"}},
+				},
+				Where: WhereClause{
+					Cond: &BooleanTerm{
+						Boolean: Token{Type: AND},
+						Left: &ComparisonExpr{
+							Operator:   ComparisonOperatorEqual,
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"id"}},
+							RightValue: ValueExpr{Type: ValueTypeDynamicParameter, ParameterIndex: 1},
+						},
+						Right: &ComparisonExpr{
+							Operator:   ComparisonOperatorEqual,
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"name"}},
+							RightValue: ValueExpr{Type: ValueTypeDynamicParameter, ParameterIndex: 2},
+						},
+					},
+				},
+			},
+		},
+	},
+	{ // # 20
+		Query: "select * from users where id = $2 and name = @name",
+		Tokens: []Token{
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 28, Column: 29}},
+			{Type: EQUAL, Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 30, Column: 31}},
+			{Type: PARAM, IntValue: 2, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 33, Column: 34}},
+			{Type: AND, Position: Position{Line: 1, Offset: 34, Column: 35}, End: Position{Line: 1, Offset: 37, Column: 38}},
+			{Type: IDENT, Value: "name", Position: Position{Line: 1, Offset: 38, Column: 39}, End: Position{Line: 1, Offset: 42, Column: 43}},
+			{Type: EQUAL, Position: Position{Line: 1, Offset: 43, Column: 44}, End: Position{Line: 1, Offset: 44, Column: 45}},
+			{Type: PARAM, Value: "name", Position: Position{Line: 1, Offset: 45, Column: 46}, End: Position{Line: 1, Offset: 50, Column: 51}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 50, Column: 51}, End: Position{Line: 1, Offset: 50, Column: 51}},
+		},
+		Ast: Select{
+			SelectList: []SelectExpr{{Asterisk: true}},
+			Table: TableExpression{
+				From: FromClause{
+					Table: []TableReference{{Name: "users"}},
+				},
+				Where: WhereClause{
+					Cond: &BooleanTerm{
+						Boolean: Token{Type: AND},
+						Left: &ComparisonExpr{
+							Operator:   ComparisonOperatorEqual,
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"id"}},
+							RightValue: ValueExpr{Type: ValueTypeDynamicParameter, ParameterIndex: 2},
+						},
+						Right: &ComparisonExpr{
+							Operator:   ComparisonOperatorEqual,
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"name"}},
+							RightValue: ValueExpr{Type: ValueTypeNamedParameter, ParameterName: "name"},
+						},
+					},
+				},
+			},
+		},
+	},
+	{ // # 21
+		Query: "select * from users where id = 1 order by id",
+		Tokens: []Token{
+			{Type: SELECT, Position: Position{Line: 1, Offset: 0, Column: 1}, End: Position{Line: 1, Offset: 6, Column: 7}},
+			{Type: ASTERISK, Position: Position{Line: 1, Offset: 7, Column: 8}, End: Position{Line: 1, Offset: 8, Column: 9}},
+			{Type: FROM, Position: Position{Line: 1, Offset: 9, Column: 10}, End: Position{Line: 1, Offset: 13, Column: 14}},
+			{Type: IDENT, Value: "users", Position: Position{Line: 1, Offset: 14, Column: 15}, End: Position{Line: 1, Offset: 19, Column: 20}},
+			{Type: WHERE, Position: Position{Line: 1, Offset: 20, Column: 21}, End: Position{Line: 1, Offset: 25, Column: 26}},
+			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 26, Column: 27}, End: Position{Line: 1, Offset: 28, Column: 29}},
+			{Type: EQUAL, Position: Position{Line: 1, Offset: 29, Column: 30}, End: Position{Line: 1, Offset: 30, Column: 31}},
+			{Type: INT, IntValue: 1, Position: Position{Line: 1, Offset: 31, Column: 32}, End: Position{Line: 1, Offset: 32, Column: 33}},
+			{Type: ORDERBY, Position: Position{Line: 1, Offset: 33, Column: 34}, End: Position{Line: 1, Offset: 41, Column: 42}},
+			{Type: IDENT, Value: "id", Position: Position{Line: 1, Offset: 42, Column: 43}, End: Position{Line: 1, Offset: 44, Column: 45}},
+			{Type: EOF, Position: Position{Line: 1, Offset: 44, Column: 45}, End: Position{Line: 1, Offset: 44, Column: 45}},
 		},
 		Ast: Select{
 			SelectList: []SelectExpr{{Asterisk: true}},
@@ -399,11 +649,190 @@ var TestSelectQuery = []TestQuery{
 				Where: WhereClause{
 					Cond: &ComparisonExpr{
 						Operator:   ComparisonOperatorEqual,
-						LeftValue:  ValueExpr{Type: ValueTypeString, StringValue: "name"},
-						RightValue: ValueExpr{Type: ValueTypeDynamicParameter},
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"id"}},
+						RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 1},
 					},
 				},
 			},
+			OrderBy: OrderByClause{{Key: Token{Type: IDENT, Value: "id"}}},
+		},
+	},
+	{ // # 22
+		Query: "SELECT * FROM t WHERE (a = 1) AND (b = 2)",
+		Ast: Select{
+			SelectList: []SelectExpr{{Asterisk: true}},
+			Table: TableExpression{
+				From: FromClause{Table: []TableReference{{Name: "t"}}},
+				Where: WhereClause{Cond: &BooleanTerm{
+					Boolean: Token{Type: AND},
+					Left: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"a"}},
+						RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 1},
+					},
+					Right: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"b"}},
+						RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 2},
+					},
+				}},
+			},
+		},
+	},
+	{ // # 23
+		Query: "SELECT * FROM t WHERE a = 1 AND (b = 2)",
+		Ast: Select{
+			SelectList: []SelectExpr{{Asterisk: true}},
+			Table: TableExpression{
+				From: FromClause{Table: []TableReference{{Name: "t"}}},
+				Where: WhereClause{Cond: &BooleanTerm{
+					Boolean: Token{Type: AND},
+					Left: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"a"}},
+						RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 1},
+					},
+					Right: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"b"}},
+						RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 2},
+					},
+				}},
+			},
+		},
+	},
+	{ // # 24
+		Query: "SELECT * FROM t WHERE (a = 1 and b = 2)",
+		Ast: Select{
+			SelectList: []SelectExpr{{Asterisk: true}},
+			Table: TableExpression{
+				From: FromClause{Table: []TableReference{{Name: "t"}}},
+				Where: WhereClause{Cond: &BooleanTerm{
+					Boolean: Token{Type: AND},
+					Left: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"a"}},
+						RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 1},
+					},
+					Right: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"b"}},
+						RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 2},
+					},
+				}},
+			},
+		},
+	},
+	{ // # 25
+		Query: "SELECT id FROM users ORDER BY id LIMIT 10",
+		Ast: Select{
+			SelectList: []SelectExpr{{Column: "id"}},
+			Table:      TableExpression{From: FromClause{Table: []TableReference{{Name: "users"}}}},
+			OrderBy:    OrderByClause{{Key: Token{Type: IDENT, Value: "id"}}},
+			Limit:      ValueExpr{Type: ValueTypeInt, IntValue: 10},
 		},
 	},
+	{ // # 26
+		Query: "SELECT * FROM t WHERE a = 1 AND b = 2 OR c = 3",
+		Ast: Select{
+			SelectList: []SelectExpr{{Asterisk: true}},
+			Table: TableExpression{
+				From: FromClause{Table: []TableReference{{Name: "t"}}},
+				Where: WhereClause{Cond: &BooleanTerm{
+					Boolean: Token{Type: OR},
+					Left: &BooleanTerm{
+						Boolean: Token{Type: AND},
+						Left: &ComparisonExpr{
+							Operator:   ComparisonOperatorEqual,
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"a"}},
+							RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 1},
+						},
+						Right: &ComparisonExpr{
+							Operator:   ComparisonOperatorEqual,
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"b"}},
+							RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 2},
+						},
+					},
+					Right: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"c"}},
+						RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 3},
+					},
+				}},
+			},
+		},
+	},
+	{ // # 27
+		Query: "SELECT * FROM t WHERE a = 1 OR b = 2 AND (c = 3 OR d = 4)",
+		Ast: Select{
+			SelectList: []SelectExpr{{Asterisk: true}},
+			Table: TableExpression{
+				From: FromClause{Table: []TableReference{{Name: "t"}}},
+				Where: WhereClause{Cond: &BooleanTerm{
+					Boolean: Token{Type: OR},
+					Left: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"a"}},
+						RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 1},
+					},
+					Right: &BooleanTerm{
+						Boolean: Token{Type: AND},
+						Left: &ComparisonExpr{
+							Operator:   ComparisonOperatorEqual,
+							LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"b"}},
+							RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 2},
+						},
+						Right: &BooleanTerm{
+							Boolean: Token{Type: OR},
+							Left: &ComparisonExpr{
+								Operator:   ComparisonOperatorEqual,
+								LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"c"}},
+								RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 3},
+							},
+							Right: &ComparisonExpr{
+								Operator:   ComparisonOperatorEqual,
+								LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"d"}},
+								RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 4},
+							},
+						},
+					},
+				}},
+			},
+		},
+	},
+}
+
+var TestSelectErrorQuery = []TestErrorQuery{
+	{ // # 0
+		Query:    "SELECT * FROM t garbage more stuff",
+		Position: Position{Line: 1, Offset: 24, Column: 25},
+	},
+	{ // # 1
+		Query:    "SELECT * FROM t WHERE a = 1)",
+		Position: Position{Line: 1, Offset: 27, Column: 28},
+	},
+	{ // # 2
+		Query:    "SELECT * FROM t LIMIT",
+		Position: Position{Line: 1, Offset: 21, Column: 22},
+	},
+	{ // # 3
+		Query:    "SELECT * FROM t WHERE AND",
+		Position: Position{Line: 1, Offset: 21, Column: 22},
+	},
+	{ // # 4
+		Query:    "SELECT * FROM t WHERE a = 1 AND",
+		Position: Position{Line: 1, Offset: 31, Column: 32},
+	},
+	{ // # 5
+		Query:    "SELECT * FROM t WHERE a.b c = 1",
+		Position: Position{Line: 1, Offset: 26, Column: 27},
+	},
+	{ // # 6
+		Query:    "SELECT * FROM t WHERE a. = 1",
+		Position: Position{Line: 1, Offset: 23, Column: 24},
+	},
+	{ // # 7
+		Query:    "SELECT * FROM t WHERE a..b = 1",
+		Position: Position{Line: 1, Offset: 24, Column: 25},
+	},
 }
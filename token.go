@@ -35,3 +35,103 @@ func (tr *TokensReader) Discard(n int) {
 		tr.tokens = tr.tokens[n:]
 	}
 }
+
+// LexerReader is a TokenReader which scans tokens from the Lexer on demand.
+// Only the tokens which are peeked but not read yet are buffered, so a large input can be parsed with bounded memory.
+// Like TokensReader over the result of Lexer.Scan, the EOF token is read before io.EOF is returned.
+type LexerReader struct {
+	lexer  *Lexer
+	tokens []Token
+	// err is the error which stopped scanning. It is io.EOF after the EOF token is scanned.
+	err error
+}
+
+func NewLexerReader(lexer *Lexer) TokenReader {
+	return &LexerReader{lexer: lexer}
+}
+
+func (lr *LexerReader) Scan() (Token, error) {
+	lr.fill(1)
+	if len(lr.tokens) > 0 {
+		t := lr.tokens[0]
+		lr.tokens = lr.tokens[1:]
+		return t, nil
+	}
+
+	return Token{Type: EOF}, lr.err
+}
+
+func (lr *LexerReader) Peek(n int) ([]Token, error) {
+	lr.fill(n)
+	if len(lr.tokens) >= n {
+		return lr.tokens[:n], nil
+	}
+
+	return nil, lr.err
+}
+
+func (lr *LexerReader) Discard(n int) {
+	lr.fill(n)
+	if len(lr.tokens) >= n {
+		lr.tokens = lr.tokens[n:]
+	}
+}
+
+// fill scans tokens until n tokens are buffered or the lexer stops.
+func (lr *LexerReader) fill(n int) {
+	for len(lr.tokens) < n && lr.err == nil {
+		t, err := lr.lexer.Scan()
+		if err != nil && err != io.EOF {
+			lr.err = err
+			return
+		}
+		lr.tokens = append(lr.tokens, t)
+		if err == io.EOF {
+			lr.err = io.EOF
+		}
+	}
+}
+
+// trailingDelimiterReader is a TokenReader which reads the delimiter at the end of the input as the EOF token,
+// so that a statement such as "SELECT * FROM t;" can be parsed as a single statement.
+type trailingDelimiterReader struct {
+	TokenReader
+}
+
+func (r *trailingDelimiterReader) Scan() (Token, error) {
+	t, err := r.Peek(1)
+	if err != nil {
+		return r.TokenReader.Scan()
+	}
+	r.TokenReader.Discard(1)
+
+	return t[0], nil
+}
+
+func (r *trailingDelimiterReader) Peek(n int) ([]Token, error) {
+	t, err := r.TokenReader.Peek(n)
+	if err != nil {
+		return nil, err
+	}
+
+	res := t
+	for i, token := range t {
+		if token.Type != SEMICOLON || !r.endsAt(t, i) {
+			continue
+		}
+		res = append(make([]Token, 0, n), t...)
+		res[i] = Token{Type: EOF, Position: token.Position, End: token.Position}
+		break
+	}
+
+	return res, nil
+}
+
+// endsAt reports whether the input ends after the i-th token of the peeked tokens t.
+func (r *trailingDelimiterReader) endsAt(t []Token, i int) bool {
+	if i+1 < len(t) {
+		return t[i+1].Type == EOF
+	}
+	next, err := r.TokenReader.Peek(i + 2)
+	return err != nil || next[i+1].Type == EOF
+}
@@ -2,15 +2,108 @@
 
 package parser
 
-import "fmt"
+import "strconv"
 
-const _TokenType_name = "ILLEGALEOFWSINTIDENTASTERISKCOMMAPERIODLPARENRPARENADDSUBEQUALLSSGTRQUESTIONSELECTINSERTUPDATEDELETECREATEALTERDROPFROMASSETINTOWHEREJOINLEFTRIGHTFULLOUTERINNERONGROUPBYORDERBYHAVINGONDUPLICATEKEYUPDATEDESCASCNULLPRIMARYKEYANDORIFNOTEXISTCOLUMNDEFAULTDATABASETABLEASSERTIONINDEXCHECKREFERENCEUNIQUEINTEGERSERIALVARCHAR"
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ILLEGAL-0]
+	_ = x[EOF-1]
+	_ = x[WS-2]
+	_ = x[INT-3]
+	_ = x[DECIMAL-4]
+	_ = x[FLOAT-5]
+	_ = x[IDENT-6]
+	_ = x[QUOTED_IDENT-7]
+	_ = x[STRING-8]
+	_ = x[COMMENT-9]
+	_ = x[ASTERISK-10]
+	_ = x[COMMA-11]
+	_ = x[PERIOD-12]
+	_ = x[LPAREN-13]
+	_ = x[RPAREN-14]
+	_ = x[PLUS-15]
+	_ = x[MINUS-16]
+	_ = x[SLASH-17]
+	_ = x[PERCENT-18]
+	_ = x[EQUAL-19]
+	_ = x[LSS-20]
+	_ = x[GTR-21]
+	_ = x[LEQ-22]
+	_ = x[GEQ-23]
+	_ = x[NEQ-24]
+	_ = x[NULLSAFEEQUAL-25]
+	_ = x[CONCAT-26]
+	_ = x[DOUBLECOLON-27]
+	_ = x[ARROW-28]
+	_ = x[LONGARROW-29]
+	_ = x[AMPERSAND-30]
+	_ = x[PIPE-31]
+	_ = x[CARET-32]
+	_ = x[TILDE-33]
+	_ = x[SHL-34]
+	_ = x[SHR-35]
+	_ = x[QUESTION-36]
+	_ = x[PARAM-37]
+	_ = x[SEMICOLON-38]
+	_ = x[DELIMITER-39]
+	_ = x[SELECT-40]
+	_ = x[INSERT-41]
+	_ = x[UPDATE-42]
+	_ = x[DELETE-43]
+	_ = x[CREATE-44]
+	_ = x[ALTER-45]
+	_ = x[ADD-46]
+	_ = x[DROP-47]
+	_ = x[FROM-48]
+	_ = x[AS-49]
+	_ = x[SET-50]
+	_ = x[INTO-51]
+	_ = x[WHERE-52]
+	_ = x[JOIN-53]
+	_ = x[LEFT-54]
+	_ = x[RIGHT-55]
+	_ = x[FULL-56]
+	_ = x[OUTER-57]
+	_ = x[INNER-58]
+	_ = x[ON-59]
+	_ = x[GROUPBY-60]
+	_ = x[ORDERBY-61]
+	_ = x[HAVING-62]
+	_ = x[LIMIT-63]
+	_ = x[ONDUPLICATEKEYUPDATE-64]
+	_ = x[DESC-65]
+	_ = x[ASC-66]
+	_ = x[NULL-67]
+	_ = x[PRIMARYKEY-68]
+	_ = x[AND-69]
+	_ = x[OR-70]
+	_ = x[IF-71]
+	_ = x[NOT-72]
+	_ = x[EXIST-73]
+	_ = x[COLUMN-74]
+	_ = x[DEFAULT-75]
+	_ = x[DATABASE-76]
+	_ = x[TABLE-77]
+	_ = x[ASSERTION-78]
+	_ = x[INDEX-79]
+	_ = x[CHECK-80]
+	_ = x[REFERENCE-81]
+	_ = x[UNIQUE-82]
+	_ = x[INTEGER-83]
+	_ = x[SERIAL-84]
+	_ = x[VARCHAR-85]
+}
+
+const _TokenType_name = "ILLEGALEOFWSINTDECIMALFLOATIDENTQUOTED_IDENTSTRINGCOMMENTASTERISKCOMMAPERIODLPARENRPARENPLUSMINUSSLASHPERCENTEQUALLSSGTRLEQGEQNEQNULLSAFEEQUALCONCATDOUBLECOLONARROWLONGARROWAMPERSANDPIPECARETTILDESHLSHRQUESTIONPARAMSEMICOLONDELIMITERSELECTINSERTUPDATEDELETECREATEALTERADDDROPFROMASSETINTOWHEREJOINLEFTRIGHTFULLOUTERINNERONGROUPBYORDERBYHAVINGLIMITONDUPLICATEKEYUPDATEDESCASCNULLPRIMARYKEYANDORIFNOTEXISTCOLUMNDEFAULTDATABASETABLEASSERTIONINDEXCHECKREFERENCEUNIQUEINTEGERSERIALVARCHAR"
 
-var _TokenType_index = [...]uint16{0, 7, 10, 12, 15, 20, 28, 33, 39, 45, 51, 54, 57, 62, 65, 68, 76, 82, 88, 94, 100, 106, 111, 115, 119, 121, 124, 128, 133, 137, 141, 146, 150, 155, 160, 162, 169, 176, 182, 202, 206, 209, 213, 223, 226, 228, 230, 233, 238, 244, 251, 259, 264, 273, 278, 283, 292, 298, 305, 311, 318}
+var _TokenType_index = [...]uint16{0, 7, 10, 12, 15, 22, 27, 32, 44, 50, 57, 65, 70, 76, 82, 88, 92, 97, 102, 109, 114, 117, 120, 123, 126, 129, 142, 148, 159, 164, 173, 182, 186, 191, 196, 199, 202, 210, 215, 224, 233, 239, 245, 251, 257, 263, 268, 271, 275, 279, 281, 284, 288, 293, 297, 301, 306, 310, 315, 320, 322, 329, 336, 342, 347, 367, 371, 374, 378, 388, 391, 393, 395, 398, 403, 409, 416, 424, 429, 438, 443, 448, 457, 463, 470, 476, 483}
 
 func (i TokenType) String() string {
-	if i < 0 || i >= TokenType(len(_TokenType_index)-1) {
-		return fmt.Sprintf("TokenType(%d)", i)
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_TokenType_index)-1 {
+		return "TokenType(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
-	return _TokenType_name[_TokenType_index[i]:_TokenType_index[i+1]]
+	return _TokenType_name[_TokenType_index[idx]:_TokenType_index[idx+1]]
 }
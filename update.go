@@ -0,0 +1,113 @@
+package parser
+
+import "io"
+
+// Update
+// Query: UPDATE users SET name = 'foo' WHERE id = 1
+//		  UPDATE users u SET u.name = 'foo' ORDER BY id LIMIT 10
+//		  UPDATE users u JOIN blog b ON u.id = b.user_id SET u.name = b.title
+//		  UPDATE users u JOIN blog b ON u.id = b.user_id LEFT JOIN comment c ON b.id = c.blog_id SET u.name = c.body
+//		  UPDATE users SET name = t.name FROM tmp t WHERE users.id = t.id
+// <update statement> ::= UPDATE <table reference list> [ <joined table>... ] SET <set clause list>
+//		[ <from clause> ] [ <where clause> ] [ <order by clause> ] [ <limit clause> ]
+// <set clause list> ::= <set clause> [ { <comma> <set clause> }... ]
+// <set clause> ::= <column reference> <equals operator> <update source>
+// <limit clause> ::= LIMIT <value expression>
+
+type Update struct {
+	Span
+	Table TableReference
+	// Tables is the other tables of the MySQL multi-table form such as UPDATE t1, t2 SET ...
+	Tables TableList
+	Join   []JoinedTable
+	Set    []*Assignment
+	// From is the FROM clause of PostgreSQL.
+	From    FromClause
+	Where   WhereClause
+	OrderBy OrderByClause
+	// Limit is nil when the statement has no LIMIT.
+	Limit Expr
+}
+
+func (*Update) statementNode() {}
+
+func (p *Parser) parseUpdate(tokens TokenReader) (Statement, error) {
+	start, err := p.expect(tokens, UPDATE)
+	if err != nil {
+		return nil, err
+	}
+	query := &Update{Span: Span{StartPos: start.Position}}
+
+	tables, err := p.parseTableList(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.Table = tables[0]
+	query.Tables = tables[1:]
+
+	join, err := p.parseJoinedTable(tokens)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	query.Join = join
+
+	if _, err := p.expect(tokens, SET); err != nil {
+		return nil, err
+	}
+	assignments, err := p.parseAssignments(tokens, func(t Token) bool { return t.Type == FROM || isClauseStart(t) })
+	if err != nil {
+		return nil, err
+	}
+	query.Set = assignments
+
+	if t, err := tokens.Peek(1); err == nil && t[0].Type == FROM {
+		from, err := p.parseFromClause(tokens)
+		if err != nil {
+			return nil, err
+		}
+		query.From = from
+	}
+
+	if t, err := tokens.Peek(1); err == nil && t[0].Type == WHERE {
+		where, err := p.parseWhereClause(tokens)
+		if err != nil {
+			return nil, err
+		}
+		query.Where = where
+	}
+
+	orderBy, err := p.parseOrderByClause(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.OrderBy = orderBy
+
+	limit, err := p.parseLimitClause(tokens)
+	if err != nil {
+		return nil, err
+	}
+	query.Limit = limit
+
+	if err := p.expectEnd(tokens); err != nil {
+		return nil, err
+	}
+	query.EndPos = p.reader.last.End
+
+	return query, nil
+}
+
+// parseLimitClause parses LIMIT and returns nil when there is no LIMIT.
+func (p *Parser) parseLimitClause(tokens TokenReader) (Expr, error) {
+	if t, err := tokens.Peek(1); err != nil || t[0].Type != LIMIT {
+		return nil, nil
+	} else {
+		tokens.Discard(1)
+	}
+
+	limit := p.collect(tokens, isClauseStart)
+	if len(limit) == 0 {
+		return nil, p.unexpected(peekToken(tokens), INT, QUESTION, PARAM)
+	}
+
+	return p.parseExpr(limit)
+}
@@ -0,0 +1,170 @@
+package parser
+
+var TestUpdateQuery = []TestQuery{
+	{ // # 0
+		Query: "update users set name = \"test\" where id = 1",
+		Ast: &Update{
+			Table: TableReference{Name: "users"},
+			Set: []*Assignment{
+				{Column: []string{"name"}, Value: ValueExpr{Type: ValueTypeString, StringValue: "test"}},
+			},
+			Where: WhereClause{Cond: &ComparisonExpr{
+				Operator:   ComparisonOperatorEqual,
+				LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"id"}},
+				RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 1},
+			}},
+		},
+	},
+	{ // # 1
+		Query: "update users as u set u.name = 'a', age = default where u.id = ? order by id desc limit 10",
+		Ast: &Update{
+			Table: TableReference{Name: "users", Alias: "u"},
+			Set: []*Assignment{
+				{Column: []string{"u", "name"}, Value: ValueExpr{Type: ValueTypeString, StringValue: "a"}},
+				{Column: []string{"age"}, Value: ValueExpr{Type: ValueTypeDefault}},
+			},
+			Where: WhereClause{Cond: &ComparisonExpr{
+				Operator:   ComparisonOperatorEqual,
+				LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"u", "id"}},
+				RightValue: ValueExpr{Type: ValueTypeDynamicParameter, ParameterIndex: 1},
+			}},
+			OrderBy: OrderByClause{{Key: Token{Type: IDENT, Value: "id"}, Order: Token{Type: DESC}}},
+			Limit:   ValueExpr{Type: ValueTypeInt, IntValue: 10},
+		},
+	},
+	{ // # 2
+		Query: "UPDATE users u JOIN blog b ON u.id = b.user_id SET u.name = b.title WHERE b.id > 10",
+		Ast: &Update{
+			Table: TableReference{Name: "users", Alias: "u"},
+			Join: []JoinedTable{{
+				Table: TableList{{Name: "blog", Alias: "b"}},
+				Cond: &ComparisonExpr{
+					Operator:   ComparisonOperatorEqual,
+					LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"u", "id"}},
+					RightValue: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"b", "user_id"}},
+				},
+			}},
+			Set: []*Assignment{
+				{Column: []string{"u", "name"}, Value: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"b", "title"}}},
+			},
+			Where: WhereClause{Cond: &ComparisonExpr{
+				Operator:   ComparisonOperatorGreaterThan,
+				LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"b", "id"}},
+				RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 10},
+			}},
+		},
+	},
+	{ // # 3
+		Query: "update users, blog set users.name = blog.title where users.id = blog.user_id",
+		Ast: &Update{
+			Table:  TableReference{Name: "users"},
+			Tables: TableList{{Name: "blog"}},
+			Set: []*Assignment{
+				{Column: []string{"users", "name"}, Value: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"blog", "title"}}},
+			},
+			Where: WhereClause{Cond: &ComparisonExpr{
+				Operator:   ComparisonOperatorEqual,
+				LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"users", "id"}},
+				RightValue: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"blog", "user_id"}},
+			}},
+		},
+	},
+	{ // # 4
+		Query: "UPDATE users SET name = t.name FROM tmp t WHERE users.id = t.id",
+		Ast: &Update{
+			Table: TableReference{Name: "users"},
+			Set: []*Assignment{
+				{Column: []string{"name"}, Value: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"t", "name"}}},
+			},
+			From: FromClause{Table: TableList{{Name: "tmp", Alias: "t"}}},
+			Where: WhereClause{Cond: &ComparisonExpr{
+				Operator:   ComparisonOperatorEqual,
+				LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"users", "id"}},
+				RightValue: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"t", "id"}},
+			}},
+		},
+	},
+	{ // # 5
+		Query: "update counters set n = n + 1, total = (total - 1) * 2, ratio = -1 * rate / 100 where id = 1",
+		Ast: &Update{
+			Table: TableReference{Name: "counters"},
+			Set: []*Assignment{
+				{Column: []string{"n"}, Value: &ArithmeticExpr{
+					Operator: Token{Type: PLUS},
+					Left:     ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"n"}},
+					Right:    ValueExpr{Type: ValueTypeInt, IntValue: 1},
+				}},
+				{Column: []string{"total"}, Value: &ArithmeticExpr{
+					Operator: Token{Type: ASTERISK},
+					Left: &ArithmeticExpr{
+						Operator: Token{Type: MINUS},
+						Left:     ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"total"}},
+						Right:    ValueExpr{Type: ValueTypeInt, IntValue: 1},
+					},
+					Right: ValueExpr{Type: ValueTypeInt, IntValue: 2},
+				}},
+				{Column: []string{"ratio"}, Value: &ArithmeticExpr{
+					Operator: Token{Type: SLASH},
+					Left: &ArithmeticExpr{
+						Operator: Token{Type: ASTERISK},
+						Left:     ValueExpr{Type: ValueTypeInt, IntValue: -1},
+						Right:    ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"rate"}},
+					},
+					Right: ValueExpr{Type: ValueTypeInt, IntValue: 100},
+				}},
+			},
+			Where: WhereClause{Cond: &ComparisonExpr{
+				Operator:   ComparisonOperatorEqual,
+				LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"id"}},
+				RightValue: ValueExpr{Type: ValueTypeInt, IntValue: 1},
+			}},
+		},
+	},
+	{ // # 6
+		Query: "UPDATE users u JOIN blog b ON u.id = b.user_id LEFT JOIN comment c ON b.id = c.blog_id SET u.name = c.body",
+		Ast: &Update{
+			Table: TableReference{Name: "users", Alias: "u"},
+			Join: []JoinedTable{
+				{
+					Table: TableList{{Name: "blog", Alias: "b"}},
+					Cond: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"u", "id"}},
+						RightValue: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"b", "user_id"}},
+					},
+				},
+				{
+					Type:  Tokens{{Type: LEFT}},
+					Table: TableList{{Name: "comment", Alias: "c"}},
+					Cond: &ComparisonExpr{
+						Operator:   ComparisonOperatorEqual,
+						LeftValue:  ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"b", "id"}},
+						RightValue: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"c", "blog_id"}},
+					},
+				},
+			},
+			Set: []*Assignment{
+				{Column: []string{"u", "name"}, Value: ValueExpr{Type: ValueTypeParameter, Identifiers: []string{"c", "body"}}},
+			},
+		},
+	},
+}
+
+var TestUpdateErrorQuery = []TestErrorQuery{
+	{ // # 0
+		Query:    "UPDATE t SET = 1",
+		Position: Position{Line: 1, Offset: 13, Column: 14},
+	},
+	{ // # 1
+		Query:    "UPDATE t SET a = 1, = 2",
+		Position: Position{Line: 1, Offset: 20, Column: 21},
+	},
+	{ // # 2
+		Query:    "UPDATE t SET n = n +",
+		Position: Position{Line: 1, Offset: 19, Column: 20},
+	},
+	{ // # 3
+		Query:    "UPDATE t SET n = n + * 2",
+		Position: Position{Line: 1, Offset: 21, Column: 22},
+	},
+}